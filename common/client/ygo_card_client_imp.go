@@ -3,11 +3,14 @@ package client
 import (
 	context "context"
 	"fmt"
+	"io"
+	"iter"
 	"net/http"
 
 	"github.com/ygo-skc/skc-go/common/v2/model"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -21,11 +24,35 @@ type YGOCardClientImp interface {
 	GetCardsByIDProto(context.Context, model.CardIDs) (*ygo.Cards, *model.APIError)
 	GetCardsByID(context.Context, model.CardIDs) (*model.BatchCardData[model.CardIDs], *model.APIError)
 
+	SearchCardsProto(context.Context, *ygo.CardSearchRequest) (*ygo.CardSearchResults, *model.APIError)
+	SearchCards(context.Context, *ygo.CardSearchRequest) (*model.CardSearchResults, *model.APIError)
+
+	SearchCardTextProto(context.Context, *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, *model.APIError)
+	SearchCardText(context.Context, *ygo.CardTextSearchRequest) (*model.CardTextSearchResults, *model.APIError)
+
+	StreamAllCards(context.Context, string, uint32) iter.Seq2[*ygo.Card, error]
+
 	GetCardsByNameProto(context.Context, model.CardNames) (*ygo.Cards, *model.APIError)
 	GetCardsByName(context.Context, model.CardNames) (*model.BatchCardData[model.CardNames], *model.APIError)
+	GetCardsByNameWithSuggestions(context.Context, model.CardNames) (*model.BatchCardData[model.CardNames], *model.APIError)
+
+	GetCardNameSuggestionsProto(context.Context, string, uint32) (*ygo.CardNameSuggestions, *model.APIError)
+	GetCardNameSuggestions(context.Context, string, uint32) ([]model.CardNameSuggestion, *model.APIError)
+
+	GetCardsReferencingNameInEffectProto(context.Context, []string, bool) (*ygo.CardList, *model.APIError)
+	GetCardsReferencingNameInEffect(context.Context, []string, bool) (*model.CardListWithHighlights, *model.APIError)
+
+	GetExtraDeckMonstersUsingMaterialProto(context.Context, string) (*ygo.MaterialUsages, *model.APIError)
+	GetExtraDeckMonstersUsingMaterial(context.Context, string) (*model.MaterialUsages, *model.APIError)
 
-	GetCardsReferencingNameInEffectProto(context.Context, []string) (*ygo.CardList, *model.APIError)
-	GetCardsReferencingNameInEffect(context.Context, []string) ([]model.YGOCard, *model.APIError)
+	GetCardEffectBreakdownProto(context.Context, string) (*ygo.CardEffectBreakdown, *model.APIError)
+	GetCardEffectBreakdown(context.Context, string) (*model.CardEffectBreakdown, *model.APIError)
+
+	GetCardReferenceGraphProto(context.Context, string, uint32) (*ygo.CardReferenceGraph, *model.APIError)
+	GetCardReferenceGraph(context.Context, string, uint32) (*model.CardReferenceGraph, *model.APIError)
+
+	GetSimilarCardsProto(context.Context, string, uint32) (*ygo.SimilarCards, *model.APIError)
+	GetSimilarCards(context.Context, string, uint32) (*model.SimilarCards, *model.APIError)
 
 	GetArchetypalCardsUsingCardNameProto(context.Context, string) (*ygo.CardList, *model.APIError)
 	GetArchetypalCardsUsingCardName(context.Context, string) ([]model.YGOCard, *model.APIError)
@@ -36,8 +63,19 @@ type YGOCardClientImp interface {
 	GetExplicitArchetypalExclusionsProto(context.Context, string) (*ygo.CardList, *model.APIError)
 	GetExplicitArchetypalExclusions(context.Context, string) ([]model.YGOCard, *model.APIError)
 
+	GetArchetypeMembersProto(context.Context, string) (*ygo.ArchetypeMembers, *model.APIError)
+	GetArchetypeMembers(context.Context, string) (*model.ArchetypeMembers, *model.APIError)
+
+	ListArchetypesProto(context.Context) (*ygo.ArchetypeCatalog, *model.APIError)
+
 	GetRandomCardProto(context.Context, []string) (*ygo.Card, *model.APIError)
 	GetRandomCard(context.Context, []string) (*model.YGOCard, *model.APIError)
+
+	GetRandomCardsProto(context.Context, *ygo.BlackListed) (*ygo.RandomCards, *model.APIError)
+	GetRandomCards(context.Context, *ygo.BlackListed) (*model.RandomCards, *model.APIError)
+
+	GetCardOfTheDayProto(context.Context, string) (*ygo.CardOfTheDay, *model.APIError)
+	GetCardOfTheDay(context.Context, string) (*model.CardOfTheDay, *model.APIError)
 }
 type YGOCardClientImpV1 struct {
 	client ygo.CardServiceClient
@@ -111,23 +149,124 @@ func getCardsByID(ctx context.Context, client ygo.CardServiceClient, cardIDs mod
 	}
 }
 
+func (imp YGOCardClientImpV1) SearchCardsProto(ctx context.Context, req *ygo.CardSearchRequest) (*ygo.CardSearchResults, *model.APIError) {
+	return searchCards(ctx, imp.client, req)
+}
+
+func (imp YGOCardClientImpV1) SearchCards(ctx context.Context, req *ygo.CardSearchRequest) (*model.CardSearchResults, *model.APIError) {
+	r, err := searchCards(ctx, imp.client, req)
+	if err == nil {
+		return model.CardSearchResultsFromProto(r), nil
+	}
+	return nil, err
+}
+
+func searchCards(ctx context.Context, client ygo.CardServiceClient, req *ygo.CardSearchRequest) (*ygo.CardSearchResults, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching cards using filter %v", req.Filter))
+
+	if results, err := client.SearchCards(ctx, req); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Search Cards", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid search request", StatusCode: http.StatusBadRequest}
+		}
+		return nil, &model.APIError{Message: "Error searching for cards", StatusCode: http.StatusInternalServerError}
+	} else {
+		return results, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) SearchCardTextProto(ctx context.Context, req *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, *model.APIError) {
+	return searchCardText(ctx, imp.client, req)
+}
+
+func (imp YGOCardClientImpV1) SearchCardText(ctx context.Context, req *ygo.CardTextSearchRequest) (*model.CardTextSearchResults, *model.APIError) {
+	r, err := searchCardText(ctx, imp.client, req)
+	if err == nil {
+		return model.CardTextSearchResultsFromProto(r), nil
+	}
+	return nil, err
+}
+
+// invalid queries use the message from the service as it explains what is wrong with the query
+func searchCardText(ctx context.Context, client ygo.CardServiceClient, req *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching card text using query %s", req.Query))
+
+	if results, err := client.SearchCardText(ctx, req); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Search Card Text", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: status.Convert(err).Message(), StatusCode: http.StatusBadRequest}
+		}
+		return nil, &model.APIError{Message: "Error searching card text", StatusCode: http.StatusInternalServerError}
+	} else {
+		return results, nil
+	}
+}
+
+// Iterates over every card modified since the RFC 3339 timestamp (or every card if timestamp is empty). Cards are streamed in chunks of pageSize.
+// Iteration stops after the first error is yielded.
+func (imp YGOCardClientImpV1) StreamAllCards(ctx context.Context, modifiedSince string, pageSize uint32) iter.Seq2[*ygo.Card, error] {
+	return func(yield func(*ygo.Card, error) bool) {
+		logger := util.RetrieveLogger(ctx)
+		logger.Info(fmt.Sprintf("Streaming all cards modified since %q", modifiedSince))
+
+		// cancelling the stream ctx releases resources if caller stops iterating before the stream is exhausted
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := imp.client.StreamAllCards(streamCtx, &ygo.CardStreamRequest{ModifiedSince: modifiedSince, PageSize: pageSize})
+		if err != nil {
+			logger.Error(fmt.Sprintf(ygoCardClientErr, "Stream All Cards", status.Code(err), err))
+			yield(nil, &model.APIError{Message: "Error streaming card catalog", StatusCode: http.StatusInternalServerError})
+			return
+		}
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				logger.Error(fmt.Sprintf(ygoCardClientErr, "Stream All Cards", status.Code(err), err))
+				yield(nil, &model.APIError{Message: "Error streaming card catalog", StatusCode: http.StatusInternalServerError})
+				return
+			}
+
+			for _, card := range chunk.Cards {
+				if !yield(card, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func (imp YGOCardClientImpV1) GetCardsByNameProto(ctx context.Context, cardNames model.CardNames) (*ygo.Cards, *model.APIError) {
-	return getCardsByName(ctx, imp.client, cardNames)
+	return getCardsByName(ctx, imp.client, cardNames, false)
 }
 
 func (imp YGOCardClientImpV1) GetCardsByName(ctx context.Context, cardNames model.CardNames) (*model.BatchCardData[model.CardNames], *model.APIError) {
-	c, err := getCardsByName(ctx, imp.client, cardNames)
+	c, err := getCardsByName(ctx, imp.client, cardNames, false)
 	if err == nil {
 		return model.BatchCardDataFromProto[model.CardNames](c, model.CardNameAsKey), nil
 	}
 	return nil, err
 }
 
-func getCardsByName(ctx context.Context, client ygo.CardServiceClient, cardNames model.CardNames) (*ygo.Cards, *model.APIError) {
+// Same as GetCardsByName but each unknown name will also contain "did you mean" suggestions
+func (imp YGOCardClientImpV1) GetCardsByNameWithSuggestions(ctx context.Context, cardNames model.CardNames) (*model.BatchCardData[model.CardNames], *model.APIError) {
+	c, err := getCardsByName(ctx, imp.client, cardNames, true)
+	if err == nil {
+		return model.BatchCardDataFromProto[model.CardNames](c, model.CardNameAsKey), nil
+	}
+	return nil, err
+}
+
+func getCardsByName(ctx context.Context, client ygo.CardServiceClient, cardNames model.CardNames, includeSuggestions bool) (*ygo.Cards, *model.APIError) {
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Fetching card info using %d card name(s)", len(cardNames)))
 
-	if cards, err := client.GetCardsByName(ctx, &ygo.ResourceNames{Names: cardNames}); err != nil {
+	if cards, err := client.GetCardsByName(ctx, &ygo.ResourceNames{Names: cardNames, IncludeSuggestions: includeSuggestions}); err != nil {
 		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Cards By Name", status.Code(err), err))
 		return nil, &model.APIError{Message: "Error fetching batch card info", StatusCode: http.StatusInternalServerError}
 	} else {
@@ -138,23 +277,48 @@ func getCardsByName(ctx context.Context, client ygo.CardServiceClient, cardNames
 	}
 }
 
-func (imp YGOCardClientImpV1) GetCardsReferencingNameInEffectProto(ctx context.Context, namesOfCards []string) (*ygo.CardList, *model.APIError) {
-	return getCardsReferencingNameInEffect(ctx, imp.client, namesOfCards)
+func (imp YGOCardClientImpV1) GetCardNameSuggestionsProto(ctx context.Context, query string, limit uint32) (*ygo.CardNameSuggestions, *model.APIError) {
+	return getCardNameSuggestions(ctx, imp.client, query, limit)
 }
 
-func (imp YGOCardClientImpV1) GetCardsReferencingNameInEffect(ctx context.Context, namesOfCards []string) ([]model.YGOCard, *model.APIError) {
-	c, err := getCardsReferencingNameInEffect(ctx, imp.client, namesOfCards)
+func (imp YGOCardClientImpV1) GetCardNameSuggestions(ctx context.Context, query string, limit uint32) ([]model.CardNameSuggestion, *model.APIError) {
+	s, err := getCardNameSuggestions(ctx, imp.client, query, limit)
 	if err == nil {
-		return model.YGOCardListRESTFromProto(c), nil
+		return model.CardNameSuggestionsFromProto(s), nil
+	}
+	return nil, err
+}
+
+func getCardNameSuggestions(ctx context.Context, client ygo.CardServiceClient, query string, limit uint32) (*ygo.CardNameSuggestions, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching card name suggestions for %s", query))
+
+	if suggestions, err := client.GetCardNameSuggestions(ctx, &ygo.CardNameSuggestionRequest{Query: query, Limit: limit}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Card Name Suggestions", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching card name suggestions", StatusCode: http.StatusInternalServerError}
+	} else {
+		return suggestions, nil
+	}
+}
+
+// highlights contain the location of every quoted name in the effect of each card, they are only populated when includeHighlights is true
+func (imp YGOCardClientImpV1) GetCardsReferencingNameInEffectProto(ctx context.Context, namesOfCards []string, includeHighlights bool) (*ygo.CardList, *model.APIError) {
+	return getCardsReferencingNameInEffect(ctx, imp.client, namesOfCards, includeHighlights)
+}
+
+func (imp YGOCardClientImpV1) GetCardsReferencingNameInEffect(ctx context.Context, namesOfCards []string, includeHighlights bool) (*model.CardListWithHighlights, *model.APIError) {
+	c, err := getCardsReferencingNameInEffect(ctx, imp.client, namesOfCards, includeHighlights)
+	if err == nil {
+		return model.CardListWithHighlightsFromProto(c), nil
 	}
 	return nil, err
 }
 
-func getCardsReferencingNameInEffect(ctx context.Context, client ygo.CardServiceClient, namesOfCards []string) (*ygo.CardList, *model.APIError) {
+func getCardsReferencingNameInEffect(ctx context.Context, client ygo.CardServiceClient, namesOfCards []string, includeHighlights bool) (*ygo.CardList, *model.APIError) {
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Fetching cards that reference the following names in their text %v", namesOfCards))
 
-	if cards, err := client.GetCardsReferencingNameInEffect(ctx, &ygo.ResourceNames{Names: namesOfCards}); err != nil {
+	if cards, err := client.GetCardsReferencingNameInEffect(ctx, &ygo.ResourceNames{Names: namesOfCards, IncludeHighlights: includeHighlights}); err != nil {
 		logger.Error(fmt.Sprintf(ygoCardClientErr, "Search Card References Using Text", status.Code(err), err))
 		return nil, &model.APIError{Message: "Error searching card text for references", StatusCode: http.StatusInternalServerError}
 	} else {
@@ -162,6 +326,117 @@ func getCardsReferencingNameInEffect(ctx context.Context, client ygo.CardService
 	}
 }
 
+func (imp YGOCardClientImpV1) GetExtraDeckMonstersUsingMaterialProto(ctx context.Context, cardID string) (*ygo.MaterialUsages, *model.APIError) {
+	return getExtraDeckMonstersUsingMaterial(ctx, imp.client, cardID)
+}
+
+func (imp YGOCardClientImpV1) GetExtraDeckMonstersUsingMaterial(ctx context.Context, cardID string) (*model.MaterialUsages, *model.APIError) {
+	u, err := getExtraDeckMonstersUsingMaterial(ctx, imp.client, cardID)
+	if err == nil {
+		return model.MaterialUsagesFromProto(u), nil
+	}
+	return nil, err
+}
+
+func getExtraDeckMonstersUsingMaterial(ctx context.Context, client ygo.CardServiceClient, cardID string) (*ygo.MaterialUsages, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching extra deck monsters that can use card w/ ID %s as material", cardID))
+
+	if usages, err := client.GetExtraDeckMonstersUsingMaterial(ctx, &ygo.ResourceID{ID: cardID}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Extra Deck Monsters Using Material", status.Code(err), err))
+		if status.Code(err) == codes.NotFound {
+			return nil, &model.APIError{Message: "Resource not found", StatusCode: http.StatusNotFound}
+		}
+		return nil, &model.APIError{Message: "Error fetching material usages", StatusCode: http.StatusInternalServerError}
+	} else {
+		return usages, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) GetCardEffectBreakdownProto(ctx context.Context, cardID string) (*ygo.CardEffectBreakdown, *model.APIError) {
+	return getCardEffectBreakdown(ctx, imp.client, cardID)
+}
+
+func (imp YGOCardClientImpV1) GetCardEffectBreakdown(ctx context.Context, cardID string) (*model.CardEffectBreakdown, *model.APIError) {
+	b, err := getCardEffectBreakdown(ctx, imp.client, cardID)
+	if err == nil {
+		return model.CardEffectBreakdownFromProto(b), nil
+	}
+	return nil, err
+}
+
+func getCardEffectBreakdown(ctx context.Context, client ygo.CardServiceClient, cardID string) (*ygo.CardEffectBreakdown, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching effect breakdown of card w/ ID %s", cardID))
+
+	if breakdown, err := client.GetCardEffectBreakdown(ctx, &ygo.ResourceID{ID: cardID}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Card Effect Breakdown", status.Code(err), err))
+		if status.Code(err) == codes.NotFound {
+			return nil, &model.APIError{Message: "Resource not found", StatusCode: http.StatusNotFound}
+		}
+		return nil, &model.APIError{Message: "Error fetching card effect breakdown", StatusCode: http.StatusInternalServerError}
+	} else {
+		return breakdown, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) GetCardReferenceGraphProto(ctx context.Context, cardID string, depth uint32) (*ygo.CardReferenceGraph, *model.APIError) {
+	return getCardReferenceGraph(ctx, imp.client, cardID, depth)
+}
+
+func (imp YGOCardClientImpV1) GetCardReferenceGraph(ctx context.Context, cardID string, depth uint32) (*model.CardReferenceGraph, *model.APIError) {
+	g, err := getCardReferenceGraph(ctx, imp.client, cardID, depth)
+	if err == nil {
+		return model.CardReferenceGraphFromProto(g), nil
+	}
+	return nil, err
+}
+
+func getCardReferenceGraph(ctx context.Context, client ygo.CardServiceClient, cardID string, depth uint32) (*ygo.CardReferenceGraph, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching reference graph of card w/ ID %s using depth %d", cardID, depth))
+
+	if graph, err := client.GetCardReferenceGraph(ctx, &ygo.CardReferenceGraphRequest{ID: cardID, Depth: depth}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Card Reference Graph", status.Code(err), err))
+		if status.Code(err) == codes.NotFound {
+			return nil, &model.APIError{Message: "Resource not found", StatusCode: http.StatusNotFound}
+		}
+		return nil, &model.APIError{Message: "Error fetching card reference graph", StatusCode: http.StatusInternalServerError}
+	} else {
+		return graph, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) GetSimilarCardsProto(ctx context.Context, cardID string, limit uint32) (*ygo.SimilarCards, *model.APIError) {
+	return getSimilarCards(ctx, imp.client, cardID, limit)
+}
+
+func (imp YGOCardClientImpV1) GetSimilarCards(ctx context.Context, cardID string, limit uint32) (*model.SimilarCards, *model.APIError) {
+	s, err := getSimilarCards(ctx, imp.client, cardID, limit)
+	if err == nil {
+		return model.SimilarCardsFromProto(s), nil
+	}
+	return nil, err
+}
+
+func getSimilarCards(ctx context.Context, client ygo.CardServiceClient, cardID string, limit uint32) (*ygo.SimilarCards, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching up to %d card(s) similar to card w/ ID %s", limit, cardID))
+
+	if similar, err := client.GetSimilarCards(ctx, &ygo.SimilarCardsRequest{ID: cardID, Limit: limit}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Similar Cards", status.Code(err), err))
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, &model.APIError{Message: "Resource not found", StatusCode: http.StatusNotFound}
+		case codes.Unavailable:
+			return nil, &model.APIError{Message: "Similar cards not available", StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil, &model.APIError{Message: "Error fetching similar cards", StatusCode: http.StatusInternalServerError}
+	} else {
+		return similar, nil
+	}
+}
+
 /*
 Archetype functionality
 */
@@ -184,6 +459,9 @@ func getArchetypalCardsUsingCardName(ctx context.Context, client ygo.CardService
 
 	if cards, err := client.GetArchetypalCardsUsingCardName(ctx, &ygo.Archetype{Archetype: archetype}); err != nil {
 		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Archetypal Cards Using Name", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid archetype", StatusCode: http.StatusBadRequest}
+		}
 		return nil, &model.APIError{Message: "Error fetching archetypal data", StatusCode: http.StatusInternalServerError}
 	} else {
 		return cards, nil
@@ -191,7 +469,7 @@ func getArchetypalCardsUsingCardName(ctx context.Context, client ygo.CardService
 }
 
 func (imp YGOCardClientImpV1) GetExplicitArchetypalInclusionsProto(ctx context.Context, archetype string) (*ygo.CardList, *model.APIError) {
-	return getArchetypalCardsUsingCardName(ctx, imp.client, archetype)
+	return getExplicitArchetypalInclusions(ctx, imp.client, archetype)
 }
 
 func (imp YGOCardClientImpV1) GetExplicitArchetypalInclusions(ctx context.Context, archetype string) ([]model.YGOCard, *model.APIError) {
@@ -208,6 +486,9 @@ func getExplicitArchetypalInclusions(ctx context.Context, client ygo.CardService
 
 	if cards, err := client.GetExplicitArchetypalInclusions(ctx, &ygo.Archetype{Archetype: archetype}); err != nil {
 		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Explicit Archetype Inclusions", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid archetype", StatusCode: http.StatusBadRequest}
+		}
 		return nil, &model.APIError{Message: "Error fetching explicit archetype inclusions", StatusCode: http.StatusInternalServerError}
 	} else {
 		return cards, nil
@@ -232,12 +513,57 @@ func getExplicitArchetypalExclusions(ctx context.Context, client ygo.CardService
 
 	if cards, err := client.GetExplicitArchetypalExclusions(ctx, &ygo.Archetype{Archetype: archetype}); err != nil {
 		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Explicit Archetype Exclusions", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid archetype", StatusCode: http.StatusBadRequest}
+		}
 		return nil, &model.APIError{Message: "Error fetching explicit archetype exclusions", StatusCode: http.StatusInternalServerError}
 	} else {
 		return cards, nil
 	}
 }
 
+func (imp YGOCardClientImpV1) GetArchetypeMembersProto(ctx context.Context, archetype string) (*ygo.ArchetypeMembers, *model.APIError) {
+	return getArchetypeMembers(ctx, imp.client, archetype)
+}
+
+func (imp YGOCardClientImpV1) GetArchetypeMembers(ctx context.Context, archetype string) (*model.ArchetypeMembers, *model.APIError) {
+	a, err := getArchetypeMembers(ctx, imp.client, archetype)
+	if err == nil {
+		return model.ArchetypeMembersFromProto(a), nil
+	}
+	return nil, err
+}
+
+func getArchetypeMembers(ctx context.Context, client ygo.CardServiceClient, archetype string) (*ygo.ArchetypeMembers, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Fetching members of archetype %s", archetype))
+
+	if members, err := client.GetArchetypeMembers(ctx, &ygo.Archetype{Archetype: archetype}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Get Archetype Members", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid archetype", StatusCode: http.StatusBadRequest}
+		}
+		return nil, &model.APIError{Message: "Error fetching archetype members", StatusCode: http.StatusInternalServerError}
+	} else {
+		return members, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) ListArchetypesProto(ctx context.Context) (*ygo.ArchetypeCatalog, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving archetype catalog")
+
+	if catalog, err := imp.client.ListArchetypes(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "List Archetypes", status.Code(err), err))
+		if status.Code(err) == codes.Unavailable {
+			return nil, &model.APIError{Message: "Archetype catalog not available", StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil, &model.APIError{Message: "Error fetching archetype catalog", StatusCode: http.StatusInternalServerError}
+	} else {
+		return catalog, nil
+	}
+}
+
 /*
 Random card functionality
 */
@@ -266,3 +592,54 @@ func getRandomCard(ctx context.Context,
 		return card, nil
 	}
 }
+
+func (imp YGOCardClientImpV1) GetRandomCardsProto(ctx context.Context, req *ygo.BlackListed) (*ygo.RandomCards, *model.APIError) {
+	return getRandomCards(ctx, imp.client, req)
+}
+
+func (imp YGOCardClientImpV1) GetRandomCards(ctx context.Context, req *ygo.BlackListed) (*model.RandomCards, *model.APIError) {
+	c, err := getRandomCards(ctx, imp.client, req)
+	if err == nil {
+		return model.RandomCardsFromProto(c), nil
+	}
+	return nil, err
+}
+
+func getRandomCards(ctx context.Context, client ygo.CardServiceClient, req *ygo.BlackListed) (*ygo.RandomCards, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Getting %d random card(s)", req.Count))
+
+	if cards, err := client.GetRandomCards(ctx, req); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Random Cards", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching random cards", StatusCode: http.StatusInternalServerError}
+	} else {
+		return cards, nil
+	}
+}
+
+func (imp YGOCardClientImpV1) GetCardOfTheDayProto(ctx context.Context, date string) (*ygo.CardOfTheDay, *model.APIError) {
+	return getCardOfTheDay(ctx, imp.client, date)
+}
+
+func (imp YGOCardClientImpV1) GetCardOfTheDay(ctx context.Context, date string) (*model.CardOfTheDay, *model.APIError) {
+	c, err := getCardOfTheDay(ctx, imp.client, date)
+	if err == nil {
+		return &model.CardOfTheDay{Date: c.Date, Card: model.YGOCardRESTFromProto(c.Card)}, nil
+	}
+	return nil, err
+}
+
+func getCardOfTheDay(ctx context.Context, client ygo.CardServiceClient, date string) (*ygo.CardOfTheDay, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Getting card of the day using date '%s'", date))
+
+	if card, err := client.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{Date: date}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCardClientErr, "Card Of The Day", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid date", StatusCode: http.StatusBadRequest}
+		}
+		return nil, &model.APIError{Message: "Error fetching card of the day", StatusCode: http.StatusInternalServerError}
+	} else {
+		return card, nil
+	}
+}
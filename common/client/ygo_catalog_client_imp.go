@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type YGOCatalogClientImp interface {
+	GetCardColorCatalogProto(context.Context) (*ygo.CardColorCatalog, *model.APIError)
+	GetCardColorCatalog(context.Context) (*model.CardColorCatalog, *model.APIError)
+	GetCardAttributesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetCardAttributes(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetMonsterTypesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetMonsterTypes(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetMonsterAbilitiesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetMonsterAbilities(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetProductTypesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetProductTypes(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetProductSubTypesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetProductSubTypes(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetRaritiesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetRarities(context.Context) (*model.CatalogEntries, *model.APIError)
+	GetLocalesProto(context.Context) (*ygo.CatalogEntries, *model.APIError)
+	GetLocales(context.Context) (*model.CatalogEntries, *model.APIError)
+}
+type YGOCatalogClientImpV1 struct {
+	client ygo.CatalogServiceClient
+}
+
+const (
+	ygoCatalogClientErr = "There was an issue calling YGO Catalog Service. Operation: %s. Code %s. Error: %s"
+)
+
+func (imp YGOCatalogClientImpV1) GetCardColorCatalogProto(ctx context.Context) (*ygo.CardColorCatalog, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving card color catalog")
+
+	if c, err := imp.client.GetCardColorCatalog(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Card Color Catalog", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching card color catalog", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetCardColorCatalog(ctx context.Context) (*model.CardColorCatalog, *model.APIError) {
+	if c, err := imp.GetCardColorCatalogProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CardColorCatalogFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetCardAttributesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving card attributes")
+
+	if c, err := imp.client.GetCardAttributes(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Card Attributes", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching card attributes", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetCardAttributes(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetCardAttributesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetMonsterTypesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving monster types")
+
+	if c, err := imp.client.GetMonsterTypes(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Monster Types", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching monster types", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetMonsterTypes(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetMonsterTypesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetMonsterAbilitiesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving monster abilities")
+
+	if c, err := imp.client.GetMonsterAbilities(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Monster Abilities", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching monster abilities", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetMonsterAbilities(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetMonsterAbilitiesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetProductTypesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving product types")
+
+	if c, err := imp.client.GetProductTypes(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Product Types", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching product types", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetProductTypes(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetProductTypesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetProductSubTypesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving product sub types")
+
+	if c, err := imp.client.GetProductSubTypes(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Product Sub Types", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching product sub types", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetProductSubTypes(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetProductSubTypesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetRaritiesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving rarities")
+
+	if c, err := imp.client.GetRarities(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Rarities", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching rarities", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetRarities(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetRaritiesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetLocalesProto(ctx context.Context) (*ygo.CatalogEntries, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving locales")
+
+	if c, err := imp.client.GetLocales(ctx, &emptypb.Empty{}); err != nil {
+		logger.Error(fmt.Sprintf(ygoCatalogClientErr, "Get Locales", status.Code(err), err))
+		return nil, &model.APIError{Message: "Error fetching locales", StatusCode: http.StatusInternalServerError}
+	} else {
+		return c, nil
+	}
+}
+
+func (imp YGOCatalogClientImpV1) GetLocales(ctx context.Context) (*model.CatalogEntries, *model.APIError) {
+	if c, err := imp.GetLocalesProto(ctx); err != nil {
+		return nil, err
+	} else {
+		return model.CatalogEntriesFromProto(c), nil
+	}
+}
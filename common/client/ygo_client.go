@@ -16,6 +16,7 @@ import (
 type YGOClientImpV1 struct {
 	CardService    YGOCardClientImp
 	ProductService YGOProductClientImp
+	CatalogService YGOCatalogClientImp
 	HealthService  YGOHealthClientImp
 }
 
@@ -23,6 +24,7 @@ func newYGOClientImpV1(conn *grpc.ClientConn) *YGOClientImpV1 {
 	return &YGOClientImpV1{
 		CardService:    &YGOCardClientImpV1{client: ygo.NewCardServiceClient(conn)},
 		ProductService: &YGOProductClientImpV1{client: ygo.NewProductServiceClient(conn)},
+		CatalogService: &YGOCatalogClientImpV1{client: ygo.NewCatalogServiceClient(conn)},
 		HealthService:  &YGOHealthClientImpV1{client: health.NewHealthServiceClient(conn)},
 	}
 }
@@ -61,6 +63,9 @@ func NewYGOServiceClients(sslServerName string, serviceHost string) (*YGOClientI
 					"BackoffMultiplier": 2.0,
 					"RetryableStatusCodes": ["UNKNOWN", "DEADLINE_EXCEEDED", "DATA_LOSS", "UNAVAILABLE"]
 				}
+			}, {
+				"name": [{"service": "ygo.CardService", "method": "StreamAllCards"}],
+				"timeout": "120s"
 			}]
 		}`),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
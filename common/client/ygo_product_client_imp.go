@@ -8,6 +8,7 @@ import (
 	"github.com/ygo-skc/skc-go/common/v2/model"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -18,6 +19,21 @@ type YGOProductClientImp interface {
 
 	GetProductsSummaryByIDProto(context.Context, model.ProductIDs) (*ygo.Products, *model.APIError)
 	GetProductsSummaryByID(context.Context, model.ProductIDs) (*model.BatchProductSummaryData[model.ProductIDs], *model.APIError)
+
+	GetProductsSummaryByNameProto(context.Context, model.ProductNames) (*ygo.Products, *model.APIError)
+	GetProductsSummaryByName(context.Context, model.ProductNames) (*model.BatchProductSummaryData[model.ProductNames], *model.APIError)
+
+	SearchProductsProto(context.Context, *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, *model.APIError)
+	SearchProducts(context.Context, *ygo.ProductSearchRequest) (*model.ProductSearchResults, *model.APIError)
+
+	GetCardsByPrintCodeProto(context.Context, model.PrintCodes) (*ygo.PrintedCards, *model.APIError)
+	GetCardsByPrintCode(context.Context, model.PrintCodes) (*model.BatchPrintedCardData[model.PrintCodes], *model.APIError)
+
+	GetCardPrintingsProto(context.Context, string) (*ygo.CardPrintings, *model.APIError)
+	GetCardPrintings(context.Context, string) (*model.CardPrintings, *model.APIError)
+
+	GetCardPrintingsByIDsProto(context.Context, model.CardIDs) (*ygo.BatchCardPrintings, *model.APIError)
+	GetCardPrintingsByIDs(context.Context, model.CardIDs) (*model.BatchCardPrintingData[model.CardIDs], *model.APIError)
 }
 type YGOProductClientImpV1 struct {
 	client ygo.ProductServiceClient
@@ -67,7 +83,7 @@ func (imp YGOProductClientImpV1) GetProductsSummaryByID(ctx context.Context,
 	productID model.ProductIDs) (*model.BatchProductSummaryData[model.ProductIDs], *model.APIError) {
 	p, err := getProductsSummaryByID(ctx, imp.client, productID)
 	if err == nil {
-		return model.BatchProductSummaryFromProductsProto(p, model.ProductIDAsKey), nil
+		return model.BatchProductSummaryFromProductsProto[model.ProductIDs](p, model.ProductIDAsKey), nil
 	}
 	return nil, err
 }
@@ -83,3 +99,132 @@ func getProductsSummaryByID(ctx context.Context, productServiceClient ygo.Produc
 		return ps, nil
 	}
 }
+
+func (imp YGOProductClientImpV1) GetProductsSummaryByNameProto(ctx context.Context, productNames model.ProductNames) (*ygo.Products, *model.APIError) {
+	return getProductsSummaryByName(ctx, imp.client, productNames)
+}
+
+func (imp YGOProductClientImpV1) GetProductsSummaryByName(ctx context.Context,
+	productNames model.ProductNames) (*model.BatchProductSummaryData[model.ProductNames], *model.APIError) {
+	p, err := getProductsSummaryByName(ctx, imp.client, productNames)
+	if err == nil {
+		return model.BatchProductSummaryFromProductsProto[model.ProductNames](p, model.ProductNameAsKey), nil
+	}
+	return nil, err
+}
+
+func getProductsSummaryByName(ctx context.Context, productServiceClient ygo.ProductServiceClient, productNames model.ProductNames) (*ygo.Products, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving summary of product(s) using %d different name(s)", len(productNames)))
+
+	if ps, err := productServiceClient.GetProductsSummaryByName(ctx, &ygo.ResourceNames{Names: productNames}); err != nil {
+		logger.Error(fmt.Sprintf(ygoProductClientErr, "Get Products Summary By Name", status.Code(err), err))
+		return nil, &model.APIError{Message: fmt.Sprintf("Error fetching product summary for product(s) %v", productNames), StatusCode: http.StatusInternalServerError}
+	} else {
+		return ps, nil
+	}
+}
+
+func (imp YGOProductClientImpV1) SearchProductsProto(ctx context.Context, req *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, *model.APIError) {
+	return searchProducts(ctx, imp.client, req)
+}
+
+func (imp YGOProductClientImpV1) SearchProducts(ctx context.Context, req *ygo.ProductSearchRequest) (*model.ProductSearchResults, *model.APIError) {
+	r, err := searchProducts(ctx, imp.client, req)
+	if err == nil {
+		return model.ProductSearchResultsFromProto(r), nil
+	}
+	return nil, err
+}
+
+func searchProducts(ctx context.Context, productServiceClient ygo.ProductServiceClient, req *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching products using filter %v", req.Filter))
+
+	if results, err := productServiceClient.SearchProducts(ctx, req); err != nil {
+		logger.Error(fmt.Sprintf(ygoProductClientErr, "Search Products", status.Code(err), err))
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, &model.APIError{Message: "Invalid search request", StatusCode: http.StatusBadRequest}
+		}
+		return nil, &model.APIError{Message: "Error searching for products", StatusCode: http.StatusInternalServerError}
+	} else {
+		return results, nil
+	}
+}
+
+func (imp YGOProductClientImpV1) GetCardsByPrintCodeProto(ctx context.Context, printCodes model.PrintCodes) (*ygo.PrintedCards, *model.APIError) {
+	return getCardsByPrintCode(ctx, imp.client, printCodes)
+}
+
+func (imp YGOProductClientImpV1) GetCardsByPrintCode(ctx context.Context,
+	printCodes model.PrintCodes) (*model.BatchPrintedCardData[model.PrintCodes], *model.APIError) {
+	p, err := getCardsByPrintCode(ctx, imp.client, printCodes)
+	if err == nil {
+		return model.BatchPrintedCardDataFromProto(p), nil
+	}
+	return nil, err
+}
+
+func getCardsByPrintCode(ctx context.Context, productServiceClient ygo.ProductServiceClient, printCodes model.PrintCodes) (*ygo.PrintedCards, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving cards using print code(s) %v", printCodes))
+
+	if pc, err := productServiceClient.GetCardsByPrintCode(ctx, &ygo.ResourceIDs{IDs: printCodes}); err != nil {
+		logger.Error(fmt.Sprintf(ygoProductClientErr, "Get Cards By Print Code", status.Code(err), err))
+		return nil, &model.APIError{Message: fmt.Sprintf("Error fetching cards for print code(s) %v", printCodes), StatusCode: http.StatusInternalServerError}
+	} else {
+		return pc, nil
+	}
+}
+
+func (imp YGOProductClientImpV1) GetCardPrintingsProto(ctx context.Context, cardID string) (*ygo.CardPrintings, *model.APIError) {
+	return getCardPrintings(ctx, imp.client, cardID)
+}
+
+func (imp YGOProductClientImpV1) GetCardPrintings(ctx context.Context, cardID string) (*model.CardPrintings, *model.APIError) {
+	p, err := getCardPrintings(ctx, imp.client, cardID)
+	if err == nil {
+		return model.CardPrintingsFromProto(p), nil
+	}
+	return nil, err
+}
+
+func getCardPrintings(ctx context.Context, productServiceClient ygo.ProductServiceClient, cardID string) (*ygo.CardPrintings, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving printings of card w/ ID %s", cardID))
+
+	if p, err := productServiceClient.GetCardPrintings(ctx, &ygo.ResourceID{ID: cardID}); err != nil {
+		logger.Error(fmt.Sprintf(ygoProductClientErr, "Get Card Printings", status.Code(err), err))
+		if status.Code(err) == codes.NotFound {
+			return nil, &model.APIError{Message: "Resource not found", StatusCode: http.StatusNotFound}
+		}
+		return nil, &model.APIError{Message: fmt.Sprintf("Error fetching printings for card %s", cardID), StatusCode: http.StatusInternalServerError}
+	} else {
+		return p, nil
+	}
+}
+
+func (imp YGOProductClientImpV1) GetCardPrintingsByIDsProto(ctx context.Context, cardIDs model.CardIDs) (*ygo.BatchCardPrintings, *model.APIError) {
+	return getCardPrintingsByIDs(ctx, imp.client, cardIDs)
+}
+
+func (imp YGOProductClientImpV1) GetCardPrintingsByIDs(ctx context.Context,
+	cardIDs model.CardIDs) (*model.BatchCardPrintingData[model.CardIDs], *model.APIError) {
+	p, err := getCardPrintingsByIDs(ctx, imp.client, cardIDs)
+	if err == nil {
+		return model.BatchCardPrintingDataFromProto(p), nil
+	}
+	return nil, err
+}
+
+func getCardPrintingsByIDs(ctx context.Context, productServiceClient ygo.ProductServiceClient, cardIDs model.CardIDs) (*ygo.BatchCardPrintings, *model.APIError) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving printings of card(s) w/ ID %v", cardIDs))
+
+	if p, err := productServiceClient.GetCardPrintingsByIDs(ctx, &ygo.ResourceIDs{IDs: cardIDs}); err != nil {
+		logger.Error(fmt.Sprintf(ygoProductClientErr, "Get Card Printings By IDs", status.Code(err), err))
+		return nil, &model.APIError{Message: fmt.Sprintf("Error fetching printings for card(s) %v", cardIDs), StatusCode: http.StatusInternalServerError}
+	} else {
+		return p, nil
+	}
+}
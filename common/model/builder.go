@@ -41,6 +41,40 @@ func (b *YGOCardProtoBuilder) WithDefense(def *uint32) *YGOCardProtoBuilder {
 	b.c.Defense = util.ProtoUInt32Value(def)
 	return b
 }
+
+// Derives materials using color and effect, both need to be set beforehand.
+func (b *YGOCardProtoBuilder) WithParsedMaterials() *YGOCardProtoBuilder {
+	b.c.Materials = MaterialsToProto(GetMaterials(YGOCardGRPC{Card: b.c}))
+	return b
+}
+
+// Derives frame, deck location and category using color, which needs to be set beforehand.
+func (b *YGOCardProtoBuilder) WithClassification() *YGOCardProtoBuilder {
+	b.c.Classification = CardClassificationToProto(ClassifyCard(YGOCardGRPC{Card: b.c}))
+	return b
+}
+
+// Tags effect with what it does (negate, draw, etc). Effect needs to be set beforehand.
+func (b *YGOCardProtoBuilder) WithEffectTags() *YGOCardProtoBuilder {
+	b.c.Tags = EffectTagsToProto(DeriveTags(YGOCardGRPC{Card: b.c}))
+	return b
+}
+
+// Splits monster type into its type, abilities and summon frames. Monster type needs to be set beforehand.
+func (b *YGOCardProtoBuilder) WithParsedMonsterType() *YGOCardProtoBuilder {
+	b.c.MonsterTypeDetails = MonsterTypeToProto(DeriveMonsterTypeDetails(YGOCardGRPC{Card: b.c}))
+	return b
+}
+
+// Splits Pendulum effect using color and effect, both need to be set beforehand.
+func (b *YGOCardProtoBuilder) WithParsedPendulumEffect() *YGOCardProtoBuilder {
+	if p := DerivePendulumEffect(YGOCardGRPC{Card: b.c}); p != nil {
+		b.c.PendulumScale = util.ProtoUInt32Value(p.Scale)
+		b.c.PendulumEffect = util.ProtoStringValue(&p.PendulumEffect)
+		b.c.MonsterEffect = util.ProtoStringValue(&p.MonsterEffect)
+	}
+	return b
+}
 func (b *YGOCardProtoBuilder) Build() *ygo.Card {
 	return b.c
 }
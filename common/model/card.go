@@ -1,9 +1,11 @@
 package model
 
 import (
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/ygo-skc/skc-go/common/v2/parser"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 )
@@ -33,6 +35,91 @@ func (c YGOCards) SortCardsByName() {
 	})
 }
 
+type CardSearchResults struct {
+	Cards      []YGOCard `json:"cards"`
+	NextCursor string    `json:"nextCursor,omitempty"`
+}
+
+type CardTextSearchResults struct {
+	Matches    []CardTextMatch `json:"matches"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+type CardTextMatch struct {
+	Card       YGOCard           `json:"card"`
+	Score      float32           `json:"score"`
+	Highlights []parser.TextSpan `json:"highlights,omitempty"`
+}
+
+// highlights are keyed by card ID
+type CardListWithHighlights struct {
+	Cards      []YGOCard                    `json:"cards"`
+	Highlights map[string][]parser.TextSpan `json:"highlights,omitempty"`
+}
+
+type RandomCards struct {
+	Cards []YGOCard `json:"cards"`
+	Seed  uint64    `json:"seed"`
+}
+
+type CardOfTheDay struct {
+	Date string  `json:"date"`
+	Card YGOCard `json:"card"`
+}
+
+type MaterialUsages struct {
+	Material           YGOCard   `json:"material"`
+	NamedExplicitly    []YGOCard `json:"namedExplicitly"`
+	MatchesGenerically []YGOCard `json:"matchesGenerically"`
+}
+
+// nodes are either cards (keyed by ID) or archetypes (keyed by name)
+type CardReferenceGraph struct {
+	Cards      CardDataMap         `json:"cards"`
+	Archetypes []string            `json:"archetypes"`
+	Edges      []CardReferenceEdge `json:"edges"`
+	Truncated  bool                `json:"truncated"`
+}
+
+type CardReferenceEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"` // name of the CardReferenceType
+}
+
+type SimilarCards struct {
+	Card    YGOCard       `json:"card"`
+	Similar []SimilarCard `json:"similar"`
+}
+
+type SimilarCard struct {
+	Card  YGOCard `json:"card"`
+	Score float32 `json:"score"`
+}
+
+type CardEffectBreakdown struct {
+	Card           YGOCard                 `json:"card"`
+	Effect         *parser.EffectBreakdown `json:"effect,omitempty"`
+	PendulumEffect *parser.EffectBreakdown `json:"pendulumEffect,omitempty"`
+}
+
+type ArchetypeMembers struct {
+	Archetype string            `json:"archetype"`
+	Members   []ArchetypeMember `json:"members"`
+	Excluded  []YGOCard         `json:"excluded"`
+}
+
+type ArchetypeMember struct {
+	Card   YGOCard `json:"card"`
+	Reason string  `json:"reason"`
+}
+
+type CardNameSuggestion struct {
+	ID    string  `json:"cardID"`
+	Name  string  `json:"cardName"`
+	Score float32 `json:"score"`
+}
+
 type YGOCardREST struct {
 	ID          string  `db:"card_number" json:"cardID"`
 	Color       string  `db:"card_color" json:"cardColor"`
@@ -42,16 +129,36 @@ type YGOCardREST struct {
 	MonsterType *string `db:"monster_type" json:"monsterType,omitempty"`
 	Attack      *uint32 `db:"monster_attack" json:"monsterAttack,omitempty"`
 	Defense     *uint32 `db:"monster_defense" json:"monsterDefense,omitempty"`
+
+	// derived from monster type
+	MonsterTypeDetails *parser.MonsterType `json:"monsterTypeDetails,omitempty"`
+
+	// derived from color
+	Classification *CardClassification `json:"classification,omitempty"`
+
+	// derived from effect
+	Tags []parser.EffectTagMatch `json:"tags,omitempty"`
+
+	// derived from effect of Pendulum cards
+	PendulumScale  *uint32 `json:"pendulumScale,omitempty"`
+	PendulumEffect *string `json:"pendulumEffect,omitempty"`
+	MonsterEffect  *string `json:"monsterEffect,omitempty"`
 }
 
-func (c YGOCardREST) GetID() string           { return c.ID }
-func (c YGOCardREST) GetColor() string        { return c.Color }
-func (c YGOCardREST) GetName() string         { return c.Name }
-func (c YGOCardREST) GetAttribute() string    { return c.Attribute }
-func (c YGOCardREST) GetEffect() string       { return c.Effect }
-func (c YGOCardREST) GetMonsterType() *string { return c.MonsterType }
-func (c YGOCardREST) GetAttack() *uint32      { return c.Attack }
-func (c YGOCardREST) GetDefense() *uint32     { return c.Defense }
+func (c YGOCardREST) GetID() string                              { return c.ID }
+func (c YGOCardREST) GetColor() string                           { return c.Color }
+func (c YGOCardREST) GetName() string                            { return c.Name }
+func (c YGOCardREST) GetAttribute() string                       { return c.Attribute }
+func (c YGOCardREST) GetEffect() string                          { return c.Effect }
+func (c YGOCardREST) GetMonsterType() *string                    { return c.MonsterType }
+func (c YGOCardREST) GetMonsterTypeDetails() *parser.MonsterType { return c.MonsterTypeDetails }
+func (c YGOCardREST) GetAttack() *uint32                         { return c.Attack }
+func (c YGOCardREST) GetDefense() *uint32                        { return c.Defense }
+func (c YGOCardREST) GetPendulumScale() *uint32                  { return c.PendulumScale }
+func (c YGOCardREST) GetPendulumEffect() *string                 { return c.PendulumEffect }
+func (c YGOCardREST) GetMonsterEffect() *string                  { return c.MonsterEffect }
+func (c YGOCardREST) GetClassification() *CardClassification     { return c.Classification }
+func (c YGOCardREST) GetTags() []parser.EffectTagMatch           { return c.Tags }
 
 func (c YGOCardREST) ToProto() *ygo.Card {
 	return &ygo.Card{
@@ -63,6 +170,14 @@ func (c YGOCardREST) ToProto() *ygo.Card {
 		MonsterType: util.ProtoStringValue(c.MonsterType),
 		Attack:      util.ProtoUInt32Value(c.Attack),
 		Defense:     util.ProtoUInt32Value(c.Defense),
+
+		MonsterTypeDetails: MonsterTypeToProto(c.MonsterTypeDetails),
+		Classification:     CardClassificationToProto(c.Classification),
+		Tags:               EffectTagsToProto(c.Tags),
+
+		PendulumScale:  util.ProtoUInt32Value(c.PendulumScale),
+		PendulumEffect: util.ProtoStringValue(c.PendulumEffect),
+		MonsterEffect:  util.ProtoStringValue(c.MonsterEffect),
 	}
 }
 
@@ -79,6 +194,9 @@ func (c YGOCardGRPC) GetMonsterType() *string {
 	}
 	return &c.MonsterType.Value
 }
+func (c YGOCardGRPC) GetMonsterTypeDetails() *parser.MonsterType {
+	return MonsterTypeFromProto(c.Card.GetMonsterTypeDetails())
+}
 func (c YGOCardGRPC) GetAttack() *uint32 {
 	if c.Attack == nil {
 		return nil
@@ -91,30 +209,175 @@ func (c YGOCardGRPC) GetDefense() *uint32 {
 	}
 	return &c.Defense.Value
 }
+func (c YGOCardGRPC) GetPendulumScale() *uint32 {
+	if c.PendulumScale == nil {
+		return nil
+	}
+	return &c.PendulumScale.Value
+}
+func (c YGOCardGRPC) GetPendulumEffect() *string {
+	if c.PendulumEffect == nil {
+		return nil
+	}
+	return &c.PendulumEffect.Value
+}
+func (c YGOCardGRPC) GetMonsterEffect() *string {
+	if c.MonsterEffect == nil {
+		return nil
+	}
+	return &c.MonsterEffect.Value
+}
+func (c YGOCardGRPC) GetClassification() *CardClassification {
+	return CardClassificationFromProto(c.Card.GetClassification())
+}
+func (c YGOCardGRPC) GetTags() []parser.EffectTagMatch {
+	return EffectTagsFromProto(c.Card.GetTags())
+}
 
-// returns true if c is an extra deck monster
+// returns true if c is an extra deck monster. Pendulum monsters are only extra deck monsters if their bottom half is.
 func IsExtraDeckMonster(c YGOCard) bool {
-	color := strings.ToUpper(c.GetColor())
-	return strings.Contains(color, "FUSION") || strings.Contains(color, "SYNCHRO") || strings.Contains(color, "XYZ") || strings.Contains(color, "PENDULUM") || strings.Contains(color, "LINK")
+	classification := ClassifyCard(c)
+	return classification != nil && classification.DeckLocation == ygo.DeckLocation_EXTRA_DECK
 }
 
 // Uses new line as delimiter to split card effect. Materials are found in the first token.
 func GetPotentialMaterialsAsString(c YGOCard) string {
-	var effectTokens []string
-
-	if !IsExtraDeckMonster(c) {
+	classification := ClassifyCard(c)
+	if classification == nil || classification.DeckLocation != ygo.DeckLocation_EXTRA_DECK {
 		return ""
 	}
 
-	color := strings.ToUpper(c.GetColor())
-	if strings.Contains(color, "PENDULUM") && color != "PENDULUM-EFFECT" && color != "PENDULUM-NORMAL" {
-		effectTokens = strings.SplitAfter(strings.SplitAfter(c.GetEffect(), "\n\nMonster Effect\n")[1], "\n")
-	} else {
-		effectTokens = strings.SplitAfter(c.GetEffect(), "\n")
+	effect := c.GetEffect()
+	if classification.Pendulum {
+		pendulumEffect := DerivePendulumEffect(c)
+		if pendulumEffect == nil {
+			return ""
+		}
+		effect = pendulumEffect.MonsterEffect
 	}
 
+	effectTokens := strings.SplitAfter(effect, "\n")
 	if len(effectTokens) < 2 {
-		return c.GetEffect()
+		return effect
 	}
 	return effectTokens[0]
 }
+
+// Every tag that applies to the effect of c (negate, draw, etc)
+func DeriveTags(c YGOCard) []parser.EffectTagMatch {
+	return parser.TagEffect(c.GetEffect())
+}
+
+// Splits monster type into its type, abilities and summon frames. Nil if c is not a monster.
+func DeriveMonsterTypeDetails(c YGOCard) *parser.MonsterType {
+	if monsterType := c.GetMonsterType(); monsterType != nil {
+		return parser.ParseMonsterType(*monsterType)
+	}
+	return nil
+}
+
+// Derives effect of Pendulum cards by splitting it into scale, pendulum effect and monster effect. Nil if c is not a Pendulum card.
+func DerivePendulumEffect(c YGOCard) *parser.PendulumEffect {
+	if classification := ClassifyCard(c); classification == nil || !classification.Pendulum {
+		return nil
+	}
+	return parser.SplitPendulumEffect(c.GetEffect())
+}
+
+// Breaks down the effect and Pendulum effect of c. Material line of extra deck monsters is not part of the effect.
+// Normal monsters only have flavor text, their effect breakdown is nil.
+func GetEffectBreakdown(c YGOCard) (effect *parser.EffectBreakdown, pendulumEffect *parser.EffectBreakdown) {
+	classification := ClassifyCard(c)
+	if classification == nil || classification.Category == ygo.CardCategory_TOKEN {
+		return nil, nil
+	}
+
+	text := c.GetEffect()
+	if classification.Pendulum {
+		p := DerivePendulumEffect(c)
+		if p == nil {
+			return nil, nil
+		}
+		text, pendulumEffect = p.MonsterEffect, parser.BreakdownEffect(p.PendulumEffect)
+	}
+	if classification.DeckLocation == ygo.DeckLocation_EXTRA_DECK {
+		text = strings.TrimPrefix(text, GetPotentialMaterialsAsString(c))
+	}
+	if classification.Frame != ygo.CardFrame_NORMAL_FRAME {
+		effect = parser.BreakdownEffect(text)
+	}
+	return effect, pendulumEffect
+}
+
+// Structured version of the material line. Nil if c is not summoned using materials or its materials could not be parsed.
+// Every extra deck monster is summoned using materials.
+func GetMaterials(c YGOCard) *parser.Materials {
+	if !IsExtraDeckMonster(c) {
+		return nil
+	}
+	return parser.ParseMaterials(GetPotentialMaterialsAsString(c))
+}
+
+type MaterialMatch int
+
+const (
+	NoMaterialMatch MaterialMatch = iota
+	GenericMaterialMatch
+	NamedMaterialMatch
+)
+
+// Determines if c can be used as material for a monster with materials m. Requirements that accept almost any monster (ie: 2+ Effect Monsters)
+// or that use data not available on a card (Level) are not considered generic matches as they would match most cards.
+func MatchMaterials(c YGOCard, m *ygo.Materials) MaterialMatch {
+	if m == nil || c.GetMonsterType() == nil {
+		return NoMaterialMatch
+	}
+
+	match := NoMaterialMatch
+	for _, r := range m.Requirements {
+		if slices.Contains(r.Names, c.GetName()) {
+			return NamedMaterialMatch
+		}
+		if isDistinctiveRequirement(r) && matchesRequirement(c, r) {
+			match = GenericMaterialMatch
+		}
+	}
+	return match
+}
+
+func isDistinctiveRequirement(r *ygo.MaterialRequirement) bool {
+	if len(r.Names) != 0 || r.MinLevel != nil || r.MaxLevel != nil {
+		return false
+	}
+	return r.Archetype != "" || len(r.Attributes) != 0 || len(r.Types) != 0 || r.Tuner == ygo.TunerRequirement_MATERIAL_TUNER ||
+		slices.ContainsFunc(r.Frames, func(frame string) bool { return frame != "Effect" })
+}
+
+func matchesRequirement(c YGOCard, r *ygo.MaterialRequirement) bool {
+	monsterType := *c.GetMonsterType()
+	monsterTypeTokens := strings.Split(monsterType, "/")
+
+	if r.Archetype != "" && !strings.Contains(c.GetName(), r.Archetype) {
+		return false
+	}
+	if len(r.Attributes) != 0 && !slices.Contains(r.Attributes, c.GetAttribute()) {
+		return false
+	}
+	if len(r.Types) != 0 && !slices.Contains(r.Types, monsterTypeTokens[0]) {
+		return false
+	}
+
+	isTuner := slices.Contains(monsterTypeTokens, "Tuner")
+	if (r.Tuner == ygo.TunerRequirement_MATERIAL_TUNER && !isTuner) || (r.Tuner == ygo.TunerRequirement_MATERIAL_NON_TUNER && isTuner) {
+		return false
+	}
+
+	color := strings.ToUpper(c.GetColor())
+	for _, frame := range r.Frames {
+		// frames such as Fusion or Link are part of the card color while abilities such as Effect or Gemini are part of the monster type
+		if !strings.Contains(color, strings.ToUpper(frame)) && !slices.Contains(monsterTypeTokens, frame) {
+			return false
+		}
+	}
+	return true
+}
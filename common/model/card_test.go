@@ -0,0 +1,174 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/parser"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGetEffectBreakdown(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName               string
+		card                   YGOCard
+		expectedEffects        []string
+		expectedPendulumEffect []string
+	}{
+		{
+			testName:        "Material line is not an effect",
+			card:            YGOCardREST{Color: "Synchro", Effect: "1 Tuner + 1+ non-Tuner monsters\nYou can discard 1 card; draw 1 card."},
+			expectedEffects: []string{"You can discard 1 card; draw 1 card."},
+		},
+		{
+			testName:               "Pendulum normal monster",
+			card:                   YGOCardREST{Color: "Pendulum-Normal", Effect: "Pendulum Effect\nOnce per turn: You can draw 1 card.\n\nFlavor Text\nA dragon."},
+			expectedPendulumEffect: []string{"Once per turn: You can draw 1 card."},
+		},
+		{
+			testName: "Normal monster",
+			card:     YGOCardREST{Color: "Normal", Effect: "A dragon."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			effect, pendulumEffect := GetEffectBreakdown(tt.card)
+			assert.Equal(tt.expectedEffects, effectTexts(effect))
+			assert.Equal(tt.expectedPendulumEffect, effectTexts(pendulumEffect))
+		})
+	}
+}
+
+func effectTexts(b *parser.EffectBreakdown) []string {
+	if b == nil {
+		return nil
+	}
+
+	texts := make([]string, len(b.Effects))
+	for i, e := range b.Effects {
+		texts[i] = e.Text
+	}
+	return texts
+}
+
+func monster(name, color, attribute, monsterType string) YGOCardREST {
+	return YGOCardREST{Name: name, Color: color, Attribute: attribute, MonsterType: &monsterType}
+}
+
+func TestIsDistinctiveRequirement(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName    string
+		requirement *ygo.MaterialRequirement
+		expected    bool
+	}{
+		{testName: "Any monster", requirement: &ygo.MaterialRequirement{Count: 2}, expected: false},
+		{testName: "Effect monsters", requirement: &ygo.MaterialRequirement{Frames: []string{"Effect"}}, expected: false},
+		{testName: "Non-Tuner monsters", requirement: &ygo.MaterialRequirement{Tuner: ygo.TunerRequirement_MATERIAL_NON_TUNER}, expected: false},
+		{testName: "Named material", requirement: &ygo.MaterialRequirement{Names: []string{"Dark Magician"}}, expected: false},
+		{testName: "Level bound", requirement: &ygo.MaterialRequirement{Attributes: []string{"DARK"}, MinLevel: wrapperspb.UInt32(4)}, expected: false},
+		{testName: "Tuner", requirement: &ygo.MaterialRequirement{Tuner: ygo.TunerRequirement_MATERIAL_TUNER}, expected: true},
+		{testName: "Archetype", requirement: &ygo.MaterialRequirement{Archetype: "HERO"}, expected: true},
+		{testName: "Attribute", requirement: &ygo.MaterialRequirement{Attributes: []string{"LIGHT"}}, expected: true},
+		{testName: "Type", requirement: &ygo.MaterialRequirement{Types: []string{"Dragon"}}, expected: true},
+		{testName: "Fusion frame", requirement: &ygo.MaterialRequirement{Frames: []string{"Fusion"}}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, isDistinctiveRequirement(tt.requirement))
+		})
+	}
+}
+
+func TestMatchesRequirement(t *testing.T) {
+	assert := assert.New(t)
+
+	tuner := monster("Junk Synchron", "Effect", "DARK", "Warrior/Tuner/Effect")
+	fusion := monster("Elemental HERO Flame Wingman", "Fusion", "WIND", "Warrior/Fusion/Effect")
+	gemini := monster("Elemental HERO Neos Alius", "Effect", "LIGHT", "Warrior/Gemini/Effect")
+
+	tests := []struct {
+		testName    string
+		card        YGOCard
+		requirement *ygo.MaterialRequirement
+		expected    bool
+	}{
+		{testName: "Tuner matches Tuner", card: tuner, requirement: &ygo.MaterialRequirement{Tuner: ygo.TunerRequirement_MATERIAL_TUNER}, expected: true},
+		{testName: "Tuner is not non-Tuner", card: tuner, requirement: &ygo.MaterialRequirement{Tuner: ygo.TunerRequirement_MATERIAL_NON_TUNER}, expected: false},
+		{testName: "Non-Tuner is not Tuner", card: gemini, requirement: &ygo.MaterialRequirement{Tuner: ygo.TunerRequirement_MATERIAL_TUNER}, expected: false},
+		{testName: "Archetype in name", card: fusion, requirement: &ygo.MaterialRequirement{Archetype: "HERO"}, expected: true},
+		{testName: "Archetype not in name", card: tuner, requirement: &ygo.MaterialRequirement{Archetype: "HERO"}, expected: false},
+		{testName: "Attribute", card: gemini, requirement: &ygo.MaterialRequirement{Attributes: []string{"DARK", "LIGHT"}}, expected: true},
+		{testName: "Wrong attribute", card: fusion, requirement: &ygo.MaterialRequirement{Attributes: []string{"DARK", "LIGHT"}}, expected: false},
+		{testName: "Type is first monster type token", card: tuner, requirement: &ygo.MaterialRequirement{Types: []string{"Warrior"}}, expected: true},
+		{testName: "Ability is not a type", card: tuner, requirement: &ygo.MaterialRequirement{Types: []string{"Tuner"}}, expected: false},
+		{testName: "Frame from color", card: fusion, requirement: &ygo.MaterialRequirement{Frames: []string{"Fusion"}}, expected: true},
+		{testName: "Ability from monster type", card: gemini, requirement: &ygo.MaterialRequirement{Frames: []string{"Gemini"}}, expected: true},
+		{testName: "Missing frame", card: gemini, requirement: &ygo.MaterialRequirement{Frames: []string{"Fusion"}}, expected: false},
+		{
+			testName:    "Every criteria needs to match",
+			card:        fusion,
+			requirement: &ygo.MaterialRequirement{Archetype: "HERO", Attributes: []string{"WIND"}, Types: []string{"Warrior"}, Frames: []string{"Fusion"}},
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, matchesRequirement(tt.card, tt.requirement))
+		})
+	}
+}
+
+func TestMatchMaterials(t *testing.T) {
+	assert := assert.New(t)
+
+	heroMaterials := &ygo.Materials{Requirements: []*ygo.MaterialRequirement{
+		{Names: []string{"Elemental HERO Avian"}, Count: 1},
+		{Attributes: []string{"FIRE"}, Count: 1},
+	}}
+	anyMaterials := &ygo.Materials{Requirements: []*ygo.MaterialRequirement{{Count: 2, OrMore: true}}}
+
+	assert.Equal(NamedMaterialMatch, MatchMaterials(monster("Elemental HERO Avian", "Normal", "WIND", "Warrior"), heroMaterials))
+	assert.Equal(GenericMaterialMatch, MatchMaterials(monster("Elemental HERO Burstinatrix", "Normal", "FIRE", "Warrior"), heroMaterials))
+	assert.Equal(NoMaterialMatch, MatchMaterials(monster("Dark Magician", "Normal", "DARK", "Spellcaster"), heroMaterials))
+	assert.Equal(NoMaterialMatch, MatchMaterials(monster("Dark Magician", "Normal", "DARK", "Spellcaster"), anyMaterials), "Any monster is not a meaningful match")
+	assert.Equal(NoMaterialMatch, MatchMaterials(YGOCardREST{Name: "Pot of Greed", Color: "Spell"}, heroMaterials), "Spells are never materials")
+	assert.Equal(NoMaterialMatch, MatchMaterials(monster("Elemental HERO Avian", "Normal", "WIND", "Warrior"), nil))
+}
+
+func TestCardListWithHighlightsFromProto(t *testing.T) {
+	assert := assert.New(t)
+
+	l := CardListWithHighlightsFromProto(&ygo.CardList{
+		Cards: []*ygo.Card{{ID: "89631139", Name: "Blue-Eyes White Dragon"}, {ID: "38517737", Name: "Blue-Eyes Alternative White Dragon"}},
+		Highlights: map[string]*ygo.TextSpans{
+			"38517737": {Spans: []*ygo.TextSpan{{Start: 10, End: 32}, {Start: 50, End: 72}}},
+		},
+	})
+
+	assert.Len(l.Cards, 2)
+	assert.Equal(map[string][]parser.TextSpan{"38517737": {{Start: 10, End: 32}, {Start: 50, End: 72}}}, l.Highlights)
+}
+
+func TestDeriveMonsterTypeDetails(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(&parser.MonsterType{Type: "Warrior", Tuner: true, Effect: true}, DeriveMonsterTypeDetails(monster("Junk Synchron", "Effect", "DARK", "Warrior/Tuner/Effect")))
+	assert.Nil(DeriveMonsterTypeDetails(YGOCardREST{Name: "Pot of Greed", Color: "Spell"}), "Spells do not have a monster type")
+}
+
+func TestDeriveTags(t *testing.T) {
+	assert := assert.New(t)
+
+	tags := DeriveTags(YGOCardREST{Name: "Pot of Greed", Color: "Spell", Effect: "Draw 2 cards."})
+	assert.Len(tags, 1)
+	assert.Equal(parser.DrawTag, tags[0].Tag)
+	assert.Empty(DeriveTags(monster("Dark Magician", "Normal", "DARK", "Spellcaster/Normal")))
+}
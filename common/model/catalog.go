@@ -0,0 +1,29 @@
+package model
+
+// =======================
+// Catalog
+// =======================
+type CatalogEntries struct {
+	Entries []CatalogEntry `json:"entries"`
+}
+
+type CatalogEntry struct {
+	Name         string `json:"name"`
+	Count        uint32 `json:"count"`
+	DisplayOrder uint32 `json:"displayOrder"`
+	Parent       string `json:"parent,omitempty"` // product type of a product sub type
+}
+
+type CardColorCatalog struct {
+	Colors []CardColorEntry `json:"colors"`
+}
+
+// enums use their names in JSON
+type CardColorEntry struct {
+	ID           uint32 `json:"colorID"`
+	Name         string `json:"cardColor"`
+	Count        uint32 `json:"count"`
+	DisplayOrder uint32 `json:"displayOrder"`
+	DeckLocation string `json:"deckLocation"`
+	Category     string `json:"category"`
+}
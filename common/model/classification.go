@@ -0,0 +1,86 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+// keyed by the upper case name of each color in the card_colors table
+var cardColorFrames = map[string]struct {
+	frame    ygo.CardFrame
+	pendulum bool
+}{
+	"NORMAL": {ygo.CardFrame_NORMAL_FRAME, false}, "EFFECT": {ygo.CardFrame_EFFECT_FRAME, false}, "RITUAL": {ygo.CardFrame_RITUAL_FRAME, false},
+	"FUSION": {ygo.CardFrame_FUSION_FRAME, false}, "SYNCHRO": {ygo.CardFrame_SYNCHRO_FRAME, false}, "XYZ": {ygo.CardFrame_XYZ_FRAME, false},
+	"PENDULUM-NORMAL": {ygo.CardFrame_NORMAL_FRAME, true}, "PENDULUM-EFFECT": {ygo.CardFrame_EFFECT_FRAME, true}, "PENDULUM-RITUAL": {ygo.CardFrame_RITUAL_FRAME, true},
+	"PENDULUM-FUSION": {ygo.CardFrame_FUSION_FRAME, true}, "PENDULUM-SYNCHRO": {ygo.CardFrame_SYNCHRO_FRAME, true}, "PENDULUM-XYZ": {ygo.CardFrame_XYZ_FRAME, true},
+	"LINK": {ygo.CardFrame_LINK_FRAME, false}, "SPELL": {ygo.CardFrame_SPELL_FRAME, false}, "TRAP": {ygo.CardFrame_TRAP_FRAME, false},
+	"TOKEN": {ygo.CardFrame_TOKEN_FRAME, false},
+}
+
+// Frame, deck location and category of a card. Pendulum monsters use the frame of their bottom half.
+type CardClassification struct {
+	Frame        ygo.CardFrame
+	Pendulum     bool
+	DeckLocation ygo.DeckLocation
+	Category     ygo.CardCategory
+}
+
+// enums use their names in JSON
+func (c CardClassification) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Frame        string `json:"frame"`
+		Pendulum     bool   `json:"pendulum"`
+		DeckLocation string `json:"deckLocation"`
+		Category     string `json:"category"`
+	}{
+		Frame:        c.Frame.String(),
+		Pendulum:     c.Pendulum,
+		DeckLocation: c.DeckLocation.String(),
+		Category:     c.Category.String(),
+	})
+}
+
+// Nil if color is not part of the card_colors table, casing of color does not matter.
+func ClassifyColor(color string) *CardClassification {
+	f, exists := cardColorFrames[strings.ToUpper(color)]
+	if !exists {
+		return nil
+	}
+
+	c := &CardClassification{Frame: f.frame, Pendulum: f.pendulum, DeckLocation: ygo.DeckLocation_MAIN_DECK, Category: ygo.CardCategory_MONSTER}
+	switch f.frame {
+	case ygo.CardFrame_FUSION_FRAME, ygo.CardFrame_SYNCHRO_FRAME, ygo.CardFrame_XYZ_FRAME, ygo.CardFrame_LINK_FRAME:
+		c.DeckLocation = ygo.DeckLocation_EXTRA_DECK
+	case ygo.CardFrame_SPELL_FRAME, ygo.CardFrame_TRAP_FRAME:
+		c.Category = ygo.CardCategory_SPELL
+		if f.frame == ygo.CardFrame_TRAP_FRAME {
+			c.Category = ygo.CardCategory_TRAP
+		}
+	case ygo.CardFrame_TOKEN_FRAME:
+		c.DeckLocation = ygo.DeckLocation_NO_DECK
+		c.Category = ygo.CardCategory_TOKEN
+	}
+	return c
+}
+
+// Nil if color of c is unknown.
+func ClassifyCard(c YGOCard) *CardClassification {
+	return ClassifyColor(c.GetColor())
+}
+
+func CardClassificationToProto(c *CardClassification) *ygo.CardClassification {
+	if c == nil {
+		return nil
+	}
+	return &ygo.CardClassification{Frame: c.Frame, Pendulum: c.Pendulum, DeckLocation: c.DeckLocation, Category: c.Category}
+}
+
+func CardClassificationFromProto(c *ygo.CardClassification) *CardClassification {
+	if c == nil {
+		return nil
+	}
+	return &CardClassification{Frame: c.Frame, Pendulum: c.Pendulum, DeckLocation: c.DeckLocation, Category: c.Category}
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+func TestClassifyCard(t *testing.T) {
+	assert := assert.New(t)
+	main, extra := ygo.DeckLocation_MAIN_DECK, ygo.DeckLocation_EXTRA_DECK
+	monster := ygo.CardCategory_MONSTER
+
+	tests := []struct {
+		color    string
+		expected *CardClassification
+	}{
+		{color: "Normal", expected: &CardClassification{Frame: ygo.CardFrame_NORMAL_FRAME, DeckLocation: main, Category: monster}},
+		{color: "Effect", expected: &CardClassification{Frame: ygo.CardFrame_EFFECT_FRAME, DeckLocation: main, Category: monster}},
+		{color: "Ritual", expected: &CardClassification{Frame: ygo.CardFrame_RITUAL_FRAME, DeckLocation: main, Category: monster}},
+		{color: "Fusion", expected: &CardClassification{Frame: ygo.CardFrame_FUSION_FRAME, DeckLocation: extra, Category: monster}},
+		{color: "Synchro", expected: &CardClassification{Frame: ygo.CardFrame_SYNCHRO_FRAME, DeckLocation: extra, Category: monster}},
+		{color: "Xyz", expected: &CardClassification{Frame: ygo.CardFrame_XYZ_FRAME, DeckLocation: extra, Category: monster}},
+		{color: "Pendulum-Normal", expected: &CardClassification{Frame: ygo.CardFrame_NORMAL_FRAME, Pendulum: true, DeckLocation: main, Category: monster}},
+		{color: "Pendulum-Effect", expected: &CardClassification{Frame: ygo.CardFrame_EFFECT_FRAME, Pendulum: true, DeckLocation: main, Category: monster}},
+		{color: "Pendulum-Ritual", expected: &CardClassification{Frame: ygo.CardFrame_RITUAL_FRAME, Pendulum: true, DeckLocation: main, Category: monster}},
+		{color: "Pendulum-Fusion", expected: &CardClassification{Frame: ygo.CardFrame_FUSION_FRAME, Pendulum: true, DeckLocation: extra, Category: monster}},
+		{color: "Pendulum-Synchro", expected: &CardClassification{Frame: ygo.CardFrame_SYNCHRO_FRAME, Pendulum: true, DeckLocation: extra, Category: monster}},
+		{color: "Pendulum-Xyz", expected: &CardClassification{Frame: ygo.CardFrame_XYZ_FRAME, Pendulum: true, DeckLocation: extra, Category: monster}},
+		{color: "Link", expected: &CardClassification{Frame: ygo.CardFrame_LINK_FRAME, DeckLocation: extra, Category: monster}},
+		{color: "Spell", expected: &CardClassification{Frame: ygo.CardFrame_SPELL_FRAME, DeckLocation: main, Category: ygo.CardCategory_SPELL}},
+		{color: "Trap", expected: &CardClassification{Frame: ygo.CardFrame_TRAP_FRAME, DeckLocation: main, Category: ygo.CardCategory_TRAP}},
+		{color: "Token", expected: &CardClassification{Frame: ygo.CardFrame_TOKEN_FRAME, DeckLocation: ygo.DeckLocation_NO_DECK, Category: ygo.CardCategory_TOKEN}},
+		{color: "Unknown", expected: nil},
+	}
+
+	assert.Len(tests, len(cardColorFrames)+1, "Every color should be tested")
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			c := YGOCardREST{Color: tt.color}
+			assert.Equal(tt.expected, ClassifyCard(c))
+			assert.Equal(tt.expected != nil && tt.expected.DeckLocation == extra, IsExtraDeckMonster(c))
+		})
+	}
+}
+
+// Spells and Traps are stored with their category as attribute and a null monster type
+func TestClassifyCardUsingSpellRow(t *testing.T) {
+	assert := assert.New(t)
+	mst := YGOCardREST{ID: "05318639", Color: "Spell", Name: "Mystical Space Typhoon", Attribute: "SPELL", Effect: "Target 1 Spell/Trap on the field; destroy that target."}
+
+	c := ClassifyCard(mst)
+	assert.Equal(ygo.CardCategory_SPELL, c.Category)
+	assert.Equal(ygo.DeckLocation_MAIN_DECK, c.DeckLocation)
+	assert.Nil(DeriveMonsterTypeDetails(mst))
+	assert.False(IsExtraDeckMonster(mst))
+}
+
+func TestGetPotentialMaterialsAsString(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		card     YGOCard
+		expected string
+	}{
+		{
+			testName: "Extra deck monster",
+			card:     YGOCardREST{Color: "Synchro", Effect: "1 Tuner + 1+ non-Tuner monsters\nDraw 1 card."},
+			expected: "1 Tuner + 1+ non-Tuner monsters\n",
+		},
+		{
+			testName: "Main deck Pendulum monster",
+			card:     YGOCardREST{Color: "Pendulum-Effect", Effect: "[ Pendulum Effect ] \nDraw 1 card.\n[ Monster Effect ] \nYou can Special Summon this card.\nDraw 1 card."},
+			expected: "",
+		},
+		{
+			testName: "Main deck monster",
+			card:     YGOCardREST{Color: "Effect", Effect: "You can Special Summon this card.\nDraw 1 card."},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, GetPotentialMaterialsAsString(tt.card))
+		})
+	}
+}
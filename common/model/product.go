@@ -35,13 +35,32 @@ func (p YGOProductREST) GetTotal() int                  { return p.Total }
 func (p YGOProductREST) GetRarityStats() map[string]int { return p.RarityStats }
 func (p YGOProductREST) GetContent() []ProductContent   { return p.Content }
 
+// Number of items printed for the first time in p
+func DeriveNewItems(p YGOProduct) int {
+	newItems := 0
+	for _, c := range p.GetContent() {
+		if c.FirstPrinting {
+			newItems++
+		}
+	}
+	return newItems
+}
+
+// Number of items of p that were printed in an earlier product
+func DeriveReprintedItems(p YGOProduct) int {
+	return len(p.GetContent()) - DeriveNewItems(p)
+}
+
 // =======================
 // Product Content
 // =====================
 type ProductContent struct {
-	Card            YGOProduct `json:"card"`
-	ProductPosition string     `json:"productPosition"`
-	Rarities        []string   `json:"rarities"`
+	Card                   YGOProduct `json:"card"`
+	ProductPosition        string     `json:"productPosition"`
+	Rarities               []string   `json:"rarities"`
+	FirstPrinting          bool       `json:"firstPrinting"`
+	FirstPrintingProductID string     `json:"firstPrintingProductId,omitempty"` // earliest product containing the card, only set for reprints
+	FirstPrintingDate      string     `json:"firstPrintingDate,omitempty"`
 }
 
 // =======================
@@ -72,3 +91,32 @@ func (p YGOProductSummaryREST) GetType() string        { return p.Type }
 func (p YGOProductSummaryREST) GetSubType() string     { return p.SubType }
 func (p YGOProductSummaryREST) GetReleaseDate() string { return p.ReleaseDate }
 func (p YGOProductSummaryREST) GetTotal() int          { return p.Total }
+
+type ProductSearchResults struct {
+	Products   []YGOProductSummary `json:"products"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+}
+
+// =======================
+// Printed Card
+// =====================
+type PrintedCard struct {
+	Card            YGOCard           `json:"card"`
+	Product         YGOProductSummary `json:"product"`
+	ProductPosition string            `json:"productPosition"`
+	Rarities        []string          `json:"rarities"`
+}
+
+// =======================
+// Card Printings
+// =====================
+type CardPrintings struct {
+	Card      YGOCard        `json:"card"`
+	Printings []CardPrinting `json:"printings"`
+}
+
+type CardPrinting struct {
+	Product         YGOProductSummary `json:"product"`
+	ProductPosition string            `json:"productPosition"`
+	Rarities        []string          `json:"rarities"`
+}
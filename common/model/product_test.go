@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+func TestDeriveNewAndReprintedItems(t *testing.T) {
+	assert := assert.New(t)
+
+	p := YGOProductREST{ID: "LOB", Content: []ProductContent{
+		{ProductPosition: "001", FirstPrinting: true},
+		{ProductPosition: "002", FirstPrinting: false, FirstPrintingProductID: "SDY", FirstPrintingDate: "2002-03-08"},
+		{ProductPosition: "003", FirstPrinting: true},
+	}}
+
+	assert.Equal(2, DeriveNewItems(p))
+	assert.Equal(1, DeriveReprintedItems(p))
+	assert.Equal(0, DeriveNewItems(YGOProductREST{ID: "LOB"}))
+	assert.Equal(0, DeriveReprintedItems(YGOProductREST{ID: "LOB"}))
+}
+
+func TestBatchProductSummaryFromProductsProtoUsingName(t *testing.T) {
+	assert := assert.New(t)
+	lob := &ygo.ProductSummary{ID: "LOB", Locale: "EN", Name: "Legend of Blue Eyes White Dragon", ReleaseDate: "2002-03-08", TotalItems: 126}
+
+	// service keys products by the name as requested, names requested using a different case point to the same product
+	batch := BatchProductSummaryFromProductsProto[ProductNames](&ygo.Products{
+		Products: map[string]*ygo.ProductSummary{
+			"legend of blue eyes white dragon": lob,
+			"Legend of Blue Eyes White Dragon": lob,
+		},
+		UnknownResources: []string{"Legend of Red Eyes"},
+	}, ProductNameAsKey)
+
+	assert.Len(batch.ProductInfo, 1, "Products are keyed by their name")
+	assert.Equal("LOB", batch.ProductInfo["Legend of Blue Eyes White Dragon"].GetID())
+	assert.Equal(ProductNames{"Legend of Red Eyes"}, batch.UnknownResources)
+}
@@ -1,7 +1,9 @@
 package model
 
 import (
+	"github.com/ygo-skc/skc-go/common/v2/parser"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func YGOCardRESTFromProto(c *ygo.Card) YGOCard {
@@ -15,33 +17,182 @@ func YGOCardRESTFromProto(c *ygo.Card) YGOCard {
 		MonsterType: ygoCardGRPC.GetMonsterType(),
 		Attack:      ygoCardGRPC.GetAttack(),
 		Defense:     ygoCardGRPC.GetDefense(),
+
+		MonsterTypeDetails: ygoCardGRPC.GetMonsterTypeDetails(),
+		Classification:     ygoCardGRPC.GetClassification(),
+		Tags:               ygoCardGRPC.GetTags(),
+
+		PendulumScale:  ygoCardGRPC.GetPendulumScale(),
+		PendulumEffect: ygoCardGRPC.GetPendulumEffect(),
+		MonsterEffect:  ygoCardGRPC.GetMonsterEffect(),
 	}
 }
 
 func YGOCardListRESTFromProto(c *ygo.CardList) []YGOCard {
 	cards := make([]YGOCard, len(c.Cards))
 	for i, c := range c.Cards {
-		ygoCardGRPC := YGOCardGRPC{Card: c}
-		cards[i] = YGOCardREST{
-			ID:          ygoCardGRPC.GetID(),
-			Color:       ygoCardGRPC.GetColor(),
-			Name:        ygoCardGRPC.GetName(),
-			Attribute:   ygoCardGRPC.GetAttribute(),
-			Effect:      ygoCardGRPC.GetEffect(),
-			MonsterType: ygoCardGRPC.GetMonsterType(),
-			Attack:      ygoCardGRPC.GetAttack(),
-			Defense:     ygoCardGRPC.GetDefense(),
-		}
+		cards[i] = YGOCardRESTFromProto(c)
 	}
 	return cards
 }
 
+func CardListWithHighlightsFromProto(c *ygo.CardList) *CardListWithHighlights {
+	highlights := make(map[string][]parser.TextSpan, len(c.Highlights))
+	for cardID, spans := range c.Highlights {
+		highlights[cardID] = TextSpansFromProto(spans.Spans)
+	}
+	return &CardListWithHighlights{Cards: YGOCardListRESTFromProto(c), Highlights: highlights}
+}
+
+func CardSearchResultsFromProto(r *ygo.CardSearchResults) *CardSearchResults {
+	return &CardSearchResults{
+		Cards:      YGOCardListRESTFromProto(&ygo.CardList{Cards: r.Cards}),
+		NextCursor: r.NextCursor,
+	}
+}
+
+func CardTextSearchResultsFromProto(r *ygo.CardTextSearchResults) *CardTextSearchResults {
+	matches := make([]CardTextMatch, len(r.Matches))
+	for i, m := range r.Matches {
+		matches[i] = CardTextMatch{Card: YGOCardRESTFromProto(m.Card), Score: m.Score, Highlights: TextSpansFromProto(m.Highlights)}
+	}
+	return &CardTextSearchResults{Matches: matches, NextCursor: r.NextCursor}
+}
+
+func TextSpansToProto(spans []parser.TextSpan) []*ygo.TextSpan {
+	protoSpans := make([]*ygo.TextSpan, len(spans))
+	for i, span := range spans {
+		protoSpans[i] = &ygo.TextSpan{Start: uint32(span.Start), End: uint32(span.End)}
+	}
+	return protoSpans
+}
+
+func TextSpansFromProto(spans []*ygo.TextSpan) []parser.TextSpan {
+	if len(spans) == 0 {
+		return nil
+	}
+	restSpans := make([]parser.TextSpan, len(spans))
+	for i, span := range spans {
+		restSpans[i] = parser.TextSpan{Start: int(span.Start), End: int(span.End)}
+	}
+	return restSpans
+}
+
+func RandomCardsFromProto(r *ygo.RandomCards) *RandomCards {
+	return &RandomCards{
+		Cards: YGOCardListRESTFromProto(&ygo.CardList{Cards: r.Cards}),
+		Seed:  r.Seed,
+	}
+}
+
+func MaterialUsagesFromProto(u *ygo.MaterialUsages) *MaterialUsages {
+	return &MaterialUsages{
+		Material:           YGOCardRESTFromProto(u.Material),
+		NamedExplicitly:    YGOCardListRESTFromProto(&ygo.CardList{Cards: u.NamedExplicitly}),
+		MatchesGenerically: YGOCardListRESTFromProto(&ygo.CardList{Cards: u.MatchesGenerically}),
+	}
+}
+
+func CardReferenceGraphFromProto(g *ygo.CardReferenceGraph) *CardReferenceGraph {
+	cards := make(CardDataMap, len(g.Cards))
+	for id, c := range g.Cards {
+		cards[id] = YGOCardRESTFromProto(c)
+	}
+
+	edges := make([]CardReferenceEdge, len(g.Edges))
+	for i, e := range g.Edges {
+		edges[i] = CardReferenceEdge{Source: e.Source, Target: e.Target, Type: e.Type.String()}
+	}
+	return &CardReferenceGraph{Cards: cards, Archetypes: g.Archetypes, Edges: edges, Truncated: g.Truncated}
+}
+
+func SimilarCardsFromProto(s *ygo.SimilarCards) *SimilarCards {
+	similar := make([]SimilarCard, len(s.Similar))
+	for i, c := range s.Similar {
+		similar[i] = SimilarCard{Card: YGOCardRESTFromProto(c.Card), Score: c.Score}
+	}
+	return &SimilarCards{Card: YGOCardRESTFromProto(s.Card), Similar: similar}
+}
+
+func CardEffectBreakdownFromProto(b *ygo.CardEffectBreakdown) *CardEffectBreakdown {
+	return &CardEffectBreakdown{
+		Card:           YGOCardRESTFromProto(b.Card),
+		Effect:         EffectBreakdownFromProto(b.Effect),
+		PendulumEffect: EffectBreakdownFromProto(b.PendulumEffect),
+	}
+}
+
+func EffectBreakdownToProto(b *parser.EffectBreakdown) *ygo.EffectBreakdown {
+	if b == nil {
+		return nil
+	}
+
+	effects := make([]*ygo.EffectClause, len(b.Effects))
+	for i, e := range b.Effects {
+		effects[i] = &ygo.EffectClause{
+			Number:      uint32(e.Number),
+			Text:        e.Text,
+			Condition:   e.Condition,
+			Cost:        e.Cost,
+			Resolution:  e.Resolution,
+			OncePerTurn: ygo.OncePerTurn(e.OncePerTurn),
+		}
+	}
+	return &ygo.EffectBreakdown{Effects: effects, Restrictions: b.Restrictions, ActivationLimited: b.ActivationLimited}
+}
+
+func EffectBreakdownFromProto(b *ygo.EffectBreakdown) *parser.EffectBreakdown {
+	if b == nil {
+		return nil
+	}
+
+	effects := make([]parser.EffectClause, len(b.Effects))
+	for i, e := range b.Effects {
+		effects[i] = parser.EffectClause{
+			Number:      int(e.Number),
+			Text:        e.Text,
+			Condition:   e.Condition,
+			Cost:        e.Cost,
+			Resolution:  e.Resolution,
+			OncePerTurn: parser.OncePerTurn(e.OncePerTurn),
+		}
+	}
+	return &parser.EffectBreakdown{Effects: effects, Restrictions: b.Restrictions, ActivationLimited: b.ActivationLimited}
+}
+
+func ArchetypeMembersFromProto(a *ygo.ArchetypeMembers) *ArchetypeMembers {
+	members := make([]ArchetypeMember, len(a.Members))
+	for i, m := range a.Members {
+		members[i] = ArchetypeMember{Card: YGOCardRESTFromProto(m.Card), Reason: m.Reason.String()}
+	}
+	return &ArchetypeMembers{
+		Archetype: a.Archetype,
+		Members:   members,
+		Excluded:  YGOCardListRESTFromProto(&ygo.CardList{Cards: a.Excluded}),
+	}
+}
+
 func BatchCardDataFromProto[T CardIDs | CardNames](c *ygo.Cards, keyFn func(*ygo.Card) string) *BatchCardData[T] {
 	batchCardData := make(CardDataMap, len(c.CardInfo))
 	for _, v := range c.CardInfo {
 		batchCardData[keyFn(v)] = YGOCardRESTFromProto(v)
 	}
-	return &BatchCardData[T]{CardInfo: batchCardData, UnknownResources: c.UnknownResources}
+	batch := &BatchCardData[T]{CardInfo: batchCardData, UnknownResources: c.UnknownResources}
+	if len(c.Suggestions) != 0 {
+		batch.Suggestions = make(map[string][]CardNameSuggestion, len(c.Suggestions))
+		for name, s := range c.Suggestions {
+			batch.Suggestions[name] = CardNameSuggestionsFromProto(s)
+		}
+	}
+	return batch
+}
+
+func CardNameSuggestionsFromProto(s *ygo.CardNameSuggestions) []CardNameSuggestion {
+	suggestions := make([]CardNameSuggestion, len(s.Suggestions))
+	for i, suggestion := range s.Suggestions {
+		suggestions[i] = CardNameSuggestion{ID: suggestion.ID, Name: suggestion.Name, Score: suggestion.Score}
+	}
+	return suggestions
 }
 
 func BatchCardDataFromProductProto[T CardIDs | CardNames](p *ygo.Product, keyFn func(*ygo.Card) string) *BatchCardData[T] {
@@ -52,7 +203,7 @@ func BatchCardDataFromProductProto[T CardIDs | CardNames](p *ygo.Product, keyFn
 	return &BatchCardData[T]{CardInfo: batchCardData, UnknownResources: make([]string, 0)}
 }
 
-func BatchProductSummaryFromProductsProto[T ProductIDs](p *ygo.Products, keyFn func(*ygo.ProductSummary) string) *BatchProductSummaryData[T] {
+func BatchProductSummaryFromProductsProto[T ProductIDs | ProductNames](p *ygo.Products, keyFn func(*ygo.ProductSummary) string) *BatchProductSummaryData[T] {
 	batchProductInfo := make(ProductSummaryDataMap, len(p.Products))
 	for _, product := range p.Products {
 		batchProductInfo[keyFn(product)] = YGOProductSummaryREST{
@@ -66,3 +217,189 @@ func BatchProductSummaryFromProductsProto[T ProductIDs](p *ygo.Products, keyFn f
 	}
 	return &BatchProductSummaryData[T]{ProductInfo: batchProductInfo, UnknownResources: p.UnknownResources}
 }
+
+func BatchPrintedCardDataFromProto(p *ygo.PrintedCards) *BatchPrintedCardData[PrintCodes] {
+	printedCards := make(map[string]PrintedCard, len(p.PrintedCards))
+	for code, printedCard := range p.PrintedCards {
+		printedCards[code] = PrintedCard{
+			Card:            YGOCardRESTFromProto(printedCard.Card),
+			Product:         YGOProductSummaryRESTFromProto(printedCard.Product),
+			ProductPosition: printedCard.Position,
+			Rarities:        printedCard.Rarities,
+		}
+	}
+	return &BatchPrintedCardData[PrintCodes]{PrintedCards: printedCards, UnknownResources: p.UnknownResources}
+}
+
+func CardPrintingsFromProto(p *ygo.CardPrintings) *CardPrintings {
+	printings := make([]CardPrinting, len(p.Printings))
+	for i, printing := range p.Printings {
+		printings[i] = CardPrinting{
+			Product:         YGOProductSummaryRESTFromProto(printing.Product),
+			ProductPosition: printing.Position,
+			Rarities:        printing.Rarities,
+		}
+	}
+	return &CardPrintings{Card: YGOCardRESTFromProto(p.Card), Printings: printings}
+}
+
+func BatchCardPrintingDataFromProto(p *ygo.BatchCardPrintings) *BatchCardPrintingData[CardIDs] {
+	cardInfo := make(map[string]CardPrintings, len(p.CardInfo))
+	for cardID, printings := range p.CardInfo {
+		cardInfo[cardID] = *CardPrintingsFromProto(printings)
+	}
+	return &BatchCardPrintingData[CardIDs]{CardInfo: cardInfo, UnknownResources: p.UnknownResources}
+}
+
+func ProductSearchResultsFromProto(r *ygo.ProductSearchResults) *ProductSearchResults {
+	products := make([]YGOProductSummary, len(r.Products))
+	for i, p := range r.Products {
+		products[i] = YGOProductSummaryRESTFromProto(p)
+	}
+	return &ProductSearchResults{Products: products, NextCursor: r.NextCursor}
+}
+
+func YGOProductSummaryRESTFromProto(p *ygo.ProductSummary) YGOProductSummary {
+	return YGOProductSummaryREST{
+		ID:          p.ID,
+		Locale:      p.Locale,
+		Name:        p.Name,
+		Type:        p.Type,
+		SubType:     p.SubType,
+		ReleaseDate: p.ReleaseDate,
+		Total:       int(p.TotalItems),
+	}
+}
+
+func MonsterTypeToProto(m *parser.MonsterType) *ygo.MonsterType {
+	if m == nil {
+		return nil
+	}
+
+	return &ygo.MonsterType{
+		Type:     m.Type,
+		Tuner:    m.Tuner,
+		Flip:     m.Flip,
+		Gemini:   m.Gemini,
+		Spirit:   m.Spirit,
+		Union:    m.Union,
+		Toon:     m.Toon,
+		Normal:   m.Normal,
+		Effect:   m.Effect,
+		Ritual:   m.Ritual,
+		Fusion:   m.Fusion,
+		Synchro:  m.Synchro,
+		Xyz:      m.Xyz,
+		Pendulum: m.Pendulum,
+		Link:     m.Link,
+	}
+}
+
+func MonsterTypeFromProto(m *ygo.MonsterType) *parser.MonsterType {
+	if m == nil {
+		return nil
+	}
+
+	return &parser.MonsterType{
+		Type:     m.Type,
+		Tuner:    m.Tuner,
+		Flip:     m.Flip,
+		Gemini:   m.Gemini,
+		Spirit:   m.Spirit,
+		Union:    m.Union,
+		Toon:     m.Toon,
+		Normal:   m.Normal,
+		Effect:   m.Effect,
+		Ritual:   m.Ritual,
+		Fusion:   m.Fusion,
+		Synchro:  m.Synchro,
+		Xyz:      m.Xyz,
+		Pendulum: m.Pendulum,
+		Link:     m.Link,
+	}
+}
+
+func EffectTagsToProto(tags []parser.EffectTagMatch) []*ygo.EffectTagMatch {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	protoTags := make([]*ygo.EffectTagMatch, len(tags))
+	for i, t := range tags {
+		protoTags[i] = &ygo.EffectTagMatch{Tag: ygo.EffectTag(t.Tag), Spans: TextSpansToProto(t.Spans)}
+	}
+	return protoTags
+}
+
+func EffectTagsFromProto(tags []*ygo.EffectTagMatch) []parser.EffectTagMatch {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	parsedTags := make([]parser.EffectTagMatch, len(tags))
+	for i, t := range tags {
+		parsedTags[i] = parser.EffectTagMatch{Tag: parser.EffectTag(t.Tag), Spans: TextSpansFromProto(t.Spans)}
+	}
+	return parsedTags
+}
+
+func MaterialsToProto(m *parser.Materials) *ygo.Materials {
+	if m == nil {
+		return nil
+	}
+
+	requirements := make([]*ygo.MaterialRequirement, len(m.Requirements))
+	for i, r := range m.Requirements {
+		requirements[i] = &ygo.MaterialRequirement{
+			Text:       r.Text,
+			Count:      uint32(r.Count),
+			OrMore:     r.OrMore,
+			Names:      r.Names,
+			Archetype:  r.Archetype,
+			Attributes: r.Attributes,
+			Types:      r.Types,
+			Frames:     r.Frames,
+			Tuner:      ygo.TunerRequirement(r.Tuner),
+			MinLevel:   nonZeroUInt32Value(r.MinLevel),
+			MaxLevel:   nonZeroUInt32Value(r.MaxLevel),
+			Inclusion:  r.Inclusion,
+		}
+	}
+
+	return &ygo.Materials{
+		Text:         m.Text,
+		MinCount:     uint32(m.MinCount),
+		MaxCount:     nonZeroUInt32Value(m.MaxCount),
+		Requirements: requirements,
+	}
+}
+
+func nonZeroUInt32Value(i int) *wrapperspb.UInt32Value {
+	if i == 0 {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(i))
+}
+
+func CatalogEntriesFromProto(c *ygo.CatalogEntries) *CatalogEntries {
+	entries := make([]CatalogEntry, len(c.Entries))
+	for i, e := range c.Entries {
+		entries[i] = CatalogEntry{Name: e.Name, Count: e.Count, DisplayOrder: e.DisplayOrder, Parent: e.Parent}
+	}
+	return &CatalogEntries{Entries: entries}
+}
+
+func CardColorCatalogFromProto(c *ygo.CardColorCatalog) *CardColorCatalog {
+	colors := make([]CardColorEntry, len(c.Colors))
+	for i, e := range c.Colors {
+		colors[i] = CardColorEntry{
+			ID:           e.ID,
+			Name:         e.Name,
+			Count:        e.Count,
+			DisplayOrder: e.DisplayOrder,
+			DeckLocation: e.DeckLocation.String(),
+			Category:     e.Category.String(),
+		}
+	}
+	return &CardColorCatalog{Colors: colors}
+}
@@ -9,8 +9,9 @@ type CardIDs []string
 type CardNames []string
 type ProductIDs []string
 type ProductNames []string
+type PrintCodes []string
 type YGOResourceKey interface {
-	CardIDs | CardNames | ProductIDs | ProductNames
+	CardIDs | CardNames | ProductIDs | ProductNames | PrintCodes
 }
 
 // =======================
@@ -32,8 +33,9 @@ type BatchProductIDs struct {
 }
 
 type BatchCardData[RK YGOResourceKey] struct {
-	CardInfo         CardDataMap `json:"cardInfo"`
-	UnknownResources RK          `json:"unknownResources"`
+	CardInfo         CardDataMap                     `json:"cardInfo"`
+	UnknownResources RK                              `json:"unknownResources"`
+	Suggestions      map[string][]CardNameSuggestion `json:"suggestions,omitempty"`
 }
 
 type BatchProductData[RK YGOResourceKey] struct {
@@ -46,14 +48,24 @@ type BatchProductSummaryData[RK YGOResourceKey] struct {
 	UnknownResources RK                    `json:"unknownResources"`
 }
 
+type BatchPrintedCardData[RK YGOResourceKey] struct {
+	PrintedCards     map[string]PrintedCard `json:"printedCards"`
+	UnknownResources RK                     `json:"unknownResources"`
+}
+
+type BatchCardPrintingData[RK YGOResourceKey] struct {
+	CardInfo         map[string]CardPrintings `json:"cardInfo"`
+	UnknownResources RK                       `json:"unknownResources"`
+}
+
 type BatchData[RK YGOResourceKey] interface {
-	BatchCardData[RK] | BatchProductData[RK] | BatchProductSummaryData[RK]
+	BatchCardData[RK] | BatchProductData[RK] | BatchProductSummaryData[RK] | BatchPrintedCardData[RK] | BatchCardPrintingData[RK]
 }
 
 // =======================
 // Data Map Key Funcs
 // =======================
-func FindMissingKeys[T CardIDs | CardNames | ProductIDs | ProductNames, R *ygo.Card | *ygo.ProductSummary | *ygo.CardScore](cards map[string]R, cardIDs T) T {
+func FindMissingKeys[T CardIDs | CardNames | ProductIDs | ProductNames | PrintCodes, R *ygo.Card | *ygo.ProductSummary | *ygo.CardScore | *ygo.PrintedCard | *ygo.CardPrintings](cards map[string]R, cardIDs T) T {
 	missingIDs := make(T, 0, 10)
 
 	for _, cardID := range cardIDs {
@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"regexp"
+)
+
+// Archetypes a card explicitly belongs to, explicitly does not belong to, or refers to in its effect
+type ArchetypeReferences struct {
+	TreatedAs    []string
+	NotTreatedAs []string
+	Referenced   []string
+}
+
+var (
+	treatedAsRegex           = regexp.MustCompile(`always treated as (?:an? )?((?:"[^"]+"(?:,? and |, | or )?)+) card`)
+	notTreatedAsRegex        = regexp.MustCompile(`not treated as (?:an? )?((?:"[^"]+"(?:,? and |, | or )?)+) card`)
+	referencedArchetypeRegex = regexp.MustCompile(`"([^"]+)" (?:monsters?|cards?|Spells?|Traps?|Spell/Trap|Spell Cards?|Trap Cards?)\b`)
+)
+
+// Uses clauses such as (This card is always treated as a "Fossil" card.) and phrases such as add 1 "HERO" monster to find archetypes in effect.
+func ParseArchetypeReferences(effect string) ArchetypeReferences {
+	refs := ArchetypeReferences{
+		TreatedAs:    quotedTokensInMatches(treatedAsRegex, effect),
+		NotTreatedAs: quotedTokensInMatches(notTreatedAsRegex, effect),
+		Referenced:   make([]string, 0),
+	}
+
+	// treated as clauses also look like references, remove them so archetypes are not counted twice
+	effect = treatedAsRegex.ReplaceAllString(effect, "")
+	effect = notTreatedAsRegex.ReplaceAllString(effect, "")
+
+	seen := make(map[string]struct{})
+	for _, match := range referencedArchetypeRegex.FindAllStringSubmatch(effect, -1) {
+		archetype := match[1]
+		CleanupToken(&archetype)
+		if _, exists := seen[archetype]; archetype != "" && !exists {
+			seen[archetype] = struct{}{}
+			refs.Referenced = append(refs.Referenced, archetype)
+		}
+	}
+	return refs
+}
+
+func quotedTokensInMatches(re *regexp.Regexp, effect string) []string {
+	tokens := make([]string, 0)
+	for _, match := range re.FindAllStringSubmatch(effect, -1) {
+		tokens = append(tokens, QuotedTokens(match[1])...)
+	}
+	return tokens
+}
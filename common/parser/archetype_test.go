@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseArchetypeReferences(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		effect   string
+		expected ArchetypeReferences
+	}{
+		{
+			testName: "Always treated as single archetype",
+			effect:   `(This card is always treated as a "Fossil" card.)` + "\nIf this card is Normal Summoned: Draw 1 card.",
+			expected: ArchetypeReferences{TreatedAs: []string{"Fossil"}, NotTreatedAs: []string{}, Referenced: []string{}},
+		},
+		{
+			testName: "Always treated as multiple archetypes",
+			effect:   `(This card is always treated as a "Destiny HERO" and "Fossil" card.)`,
+			expected: ArchetypeReferences{TreatedAs: []string{"Destiny HERO", "Fossil"}, NotTreatedAs: []string{}, Referenced: []string{}},
+		},
+		{
+			testName: "Not treated as",
+			effect:   `(This card is not treated as a "Frog" card.)`,
+			expected: ArchetypeReferences{TreatedAs: []string{}, NotTreatedAs: []string{"Frog"}, Referenced: []string{}},
+		},
+		{
+			testName: "Referenced archetypes are unique",
+			effect:   `Add 1 "HERO" monster from your Deck to your hand. You can banish 1 "Polymerization" Spell or 1 "HERO" card from your GY.`,
+			expected: ArchetypeReferences{TreatedAs: []string{}, NotTreatedAs: []string{}, Referenced: []string{"HERO", "Polymerization"}},
+		},
+		{
+			testName: "Card names are not archetype references",
+			effect:   `If "Dark Magician" is on the field: Draw 1 card.`,
+			expected: ArchetypeReferences{TreatedAs: []string{}, NotTreatedAs: []string{}, Referenced: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, ParseArchetypeReferences(tt.effect))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Order matches the OncePerTurn proto enum.
+type OncePerTurn int
+
+const (
+	NotOncePerTurn OncePerTurn = iota
+	SoftOncePerTurn
+	HardOncePerTurn
+)
+
+var oncePerTurnNames = []string{"NOT_ONCE_PER_TURN", "SOFT_ONCE_PER_TURN", "HARD_ONCE_PER_TURN"}
+
+func (o OncePerTurn) String() string {
+	if o < 0 || int(o) >= len(oncePerTurnNames) {
+		return fmt.Sprintf("OncePerTurn(%d)", int(o))
+	}
+	return oncePerTurnNames[o]
+}
+
+// limits use their names in JSON
+func (o OncePerTurn) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// A single effect of a card, ie: If this card is Normal Summoned: You can discard 1 card; draw 2 cards.
+type EffectClause struct {
+	Number      int         `json:"number"`
+	Text        string      `json:"text"`
+	Condition   string      `json:"condition,omitempty"` // text before ":"
+	Cost        string      `json:"cost,omitempty"`      // text between the condition and ";"
+	Resolution  string      `json:"resolution"`
+	OncePerTurn OncePerTurn `json:"oncePerTurn"`
+}
+
+// Effects of a card along with sentences that restrict how often they can be used. Restrictions are not part of any effect.
+type EffectBreakdown struct {
+	Effects           []EffectClause `json:"effects"`
+	Restrictions      []string       `json:"restrictions,omitempty"`
+	ActivationLimited bool           `json:"activationLimited"` // ie: You can only activate 1 "Pot of Extravagance" per turn.
+}
+
+var (
+	restrictionRegex         = regexp.MustCompile(`(?i)^You can only (use|activate|Special Summon)\b.*\bper turn\b`)
+	thisEffectLimitRegex     = regexp.MustCompile(`(?i)^You can only use this effect of ".+" once per turn`)
+	eachEffectLimitRegex     = regexp.MustCompile(`(?i)^You can only use (each effect of ".+" once per turn|1 ".+" effect per turn)`)
+	numberedEffectLimitRegex = regexp.MustCompile(`(?i)\b(\d+)(st|nd|rd|th) effect\b`)
+	activationLimitRegex     = regexp.MustCompile(`(?i)^You can only activate 1 ".+" per turn`)
+	softOncePerTurnRegex     = regexp.MustCompile(`(?i)\bonce per turn\b`)
+)
+
+/*
+Splits effect into numbered effects. Sentences with an activation condition (":") or an ignition cost ("You can ...;") start a new effect
+while other sentences continue the previous one.
+Hard once per turn restrictions are matched to the effect(s) they apply to:
+  - "this effect" applies to the effect before it
+  - "each effect" or "1 X effect per turn" applies to every effect
+  - "the 1st effect" applies to the numbered effect
+
+Effects that mention once per turn without a restriction sentence are soft once per turn.
+*/
+func BreakdownEffect(effect string) *EffectBreakdown {
+	b := &EffectBreakdown{Effects: make([]EffectClause, 0)}
+	sentenceGroups := make([][]string, 0)
+	numbered := make([]string, 0)
+	applyToAll := false
+
+	for _, line := range strings.Split(effect, "\n") {
+		for _, sentence := range splitSentences(line) {
+			switch {
+			case restrictionRegex.MatchString(sentence):
+				b.Restrictions = append(b.Restrictions, sentence)
+				switch {
+				case activationLimitRegex.MatchString(sentence):
+					b.ActivationLimited = true
+				case thisEffectLimitRegex.MatchString(sentence):
+					if len(sentenceGroups) > 0 {
+						numbered = append(numbered, strconv.Itoa(len(sentenceGroups)))
+					}
+				case eachEffectLimitRegex.MatchString(sentence):
+					applyToAll = true
+				default:
+					for _, m := range numberedEffectLimitRegex.FindAllStringSubmatch(sentence, -1) {
+						numbered = append(numbered, m[1])
+					}
+				}
+			case len(sentenceGroups) == 0 || startsEffect(sentence):
+				sentenceGroups = append(sentenceGroups, []string{sentence})
+			default:
+				sentenceGroups[len(sentenceGroups)-1] = append(sentenceGroups[len(sentenceGroups)-1], sentence)
+			}
+		}
+	}
+
+	for i, group := range sentenceGroups {
+		clause := newEffectClause(i+1, strings.Join(group, " "))
+		if applyToAll || slices.Contains(numbered, strconv.Itoa(clause.Number)) {
+			clause.OncePerTurn = HardOncePerTurn
+		}
+		b.Effects = append(b.Effects, clause)
+	}
+	return b
+}
+
+func newEffectClause(number int, text string) EffectClause {
+	c := EffectClause{Number: number, Text: text, Resolution: text}
+	if i := indexOutsideQuotes(c.Resolution, ':'); i != -1 {
+		c.Condition = strings.TrimSpace(c.Resolution[:i])
+		c.Resolution = strings.TrimSpace(c.Resolution[i+1:])
+	}
+	if i := indexOutsideQuotes(c.Resolution, ';'); i != -1 {
+		c.Cost = strings.TrimSpace(c.Resolution[:i])
+		c.Resolution = strings.TrimSpace(c.Resolution[i+1:])
+	}
+	if softOncePerTurnRegex.MatchString(c.Condition) || softOncePerTurnRegex.MatchString(c.Cost) {
+		c.OncePerTurn = SoftOncePerTurn
+	}
+	return c
+}
+
+func startsEffect(sentence string) bool {
+	return indexOutsideQuotes(sentence, ':') != -1 || (strings.HasPrefix(sentence, "You can ") && indexOutsideQuotes(sentence, ';') != -1)
+}
+
+// sentences end with a period outside of quotes that is followed by a space or the end of text. Card names such as "D.D. Warrior" are never split.
+func splitSentences(text string) []string {
+	sentences := make([]string, 0)
+	inQuotes := false
+	start := 0
+
+	for i, r := range text {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == '.' && !inQuotes && (i+1 == len(text) || text[i+1] == ' '):
+			if sentence := strings.TrimSpace(text[start : i+1]); sentence != "" {
+				sentences = append(sentences, sentence)
+			}
+			start = i + 1
+		}
+	}
+	if sentence := strings.TrimSpace(text[start:]); sentence != "" {
+		sentences = append(sentences, sentence)
+	}
+	return sentences
+}
+
+// byte index of the first occurrence of r outside of double quotes, -1 if there are none
+func indexOutsideQuotes(text string, r rune) int {
+	inQuotes := false
+	for i, c := range text {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == r && !inQuotes:
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakdownEffect(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		effect   string
+		expected *EffectBreakdown
+	}{
+		{
+			testName: "Hard once per turn for previous effect",
+			effect:   `If this card is Normal Summoned: You can discard 1 card; draw 2 cards. You can only use this effect of "Test Card" once per turn. You can banish this card from your GY; add 1 "D.D. Warrior" from your Deck to your hand.`,
+			expected: &EffectBreakdown{
+				Effects: []EffectClause{
+					{Number: 1, Text: "If this card is Normal Summoned: You can discard 1 card; draw 2 cards.", Condition: "If this card is Normal Summoned", Cost: "You can discard 1 card", Resolution: "draw 2 cards.", OncePerTurn: HardOncePerTurn},
+					{Number: 2, Text: `You can banish this card from your GY; add 1 "D.D. Warrior" from your Deck to your hand.`, Cost: "You can banish this card from your GY", Resolution: `add 1 "D.D. Warrior" from your Deck to your hand.`},
+				},
+				Restrictions: []string{`You can only use this effect of "Test Card" once per turn.`},
+			},
+		},
+		{
+			testName: "Hard once per turn for each effect and soft once per turn",
+			effect:   "Once per turn: You can target 1 monster; destroy it.\nIf this card is sent to the GY: Special Summon it. It cannot attack this turn.\nYou can only use each effect of \"Test: Card\" once per turn.",
+			expected: &EffectBreakdown{
+				Effects: []EffectClause{
+					{Number: 1, Text: "Once per turn: You can target 1 monster; destroy it.", Condition: "Once per turn", Cost: "You can target 1 monster", Resolution: "destroy it.", OncePerTurn: HardOncePerTurn},
+					{Number: 2, Text: "If this card is sent to the GY: Special Summon it. It cannot attack this turn.", Condition: "If this card is sent to the GY", Resolution: "Special Summon it. It cannot attack this turn.", OncePerTurn: HardOncePerTurn},
+				},
+				Restrictions: []string{`You can only use each effect of "Test: Card" once per turn.`},
+			},
+		},
+		{
+			testName: "Soft once per turn",
+			effect:   "Once per turn, during your Main Phase: You can draw 1 card.",
+			expected: &EffectBreakdown{
+				Effects: []EffectClause{
+					{Number: 1, Text: "Once per turn, during your Main Phase: You can draw 1 card.", Condition: "Once per turn, during your Main Phase", Resolution: "You can draw 1 card.", OncePerTurn: SoftOncePerTurn},
+				},
+			},
+		},
+		{
+			testName: "Numbered effect and activation limit",
+			effect:   `You can only activate 1 "Test Card" per turn. Draw 1 card. During your End Phase: Discard 1 card. You can only use the 2nd effect of "Test Card" once per turn.`,
+			expected: &EffectBreakdown{
+				Effects: []EffectClause{
+					{Number: 1, Text: "Draw 1 card.", Resolution: "Draw 1 card."},
+					{Number: 2, Text: "During your End Phase: Discard 1 card.", Condition: "During your End Phase", Resolution: "Discard 1 card.", OncePerTurn: HardOncePerTurn},
+				},
+				Restrictions:      []string{`You can only activate 1 "Test Card" per turn.`, `You can only use the 2nd effect of "Test Card" once per turn.`},
+				ActivationLimited: true,
+			},
+		},
+		{
+			testName: "Empty",
+			effect:   "",
+			expected: &EffectBreakdown{Effects: []EffectClause{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, BreakdownEffect(tt.effect))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// What an effect does. Order matches the EffectTag proto enum.
+type EffectTag int
+
+const (
+	NegateTag EffectTag = iota
+	DestroyTag
+	BanishTag
+	DrawTag
+	SearchTag
+	SpecialSummonTag
+	SendToGYTag
+	OncePerTurnTag
+	QuickEffectTag
+	InflictDamageTag
+	GainLPTag
+)
+
+var effectTagNames = []string{"NEGATE", "DESTROY", "BANISH", "DRAW", "SEARCH", "SPECIAL_SUMMON", "SEND_TO_GY", "ONCE_PER_TURN", "QUICK_EFFECT", "INFLICT_DAMAGE", "GAIN_LP"}
+
+func (t EffectTag) String() string {
+	if t < 0 || int(t) >= len(effectTagNames) {
+		return fmt.Sprintf("EffectTag(%d)", int(t))
+	}
+	return effectTagNames[t]
+}
+
+// tags use their names in JSON
+func (t EffectTag) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// A tag along with the text that triggered it
+type EffectTagMatch struct {
+	Tag   EffectTag  `json:"tag"`
+	Spans []TextSpan `json:"spans"`
+}
+
+// Pattern is compatible with both Go and MySQL (ICU) regular expressions. Matches inside of an exclusion are ignored,
+// ie: "cannot be destroyed" protects a card instead of destroying one.
+type effectTagRule struct {
+	tag        EffectTag
+	pattern    string
+	regex      *regexp.Regexp
+	exclusions []*regexp.Regexp
+}
+
+var effectTagRules = []effectTagRule{
+	newEffectTagRule(NegateTag, `\bnegat(e|es|ed|ing)\b`),
+	newEffectTagRule(DestroyTag, `\bdestroy(s|ed|ing)?\b`, `\bcannot be destroyed\b`, `\bwould be destroyed\b`),
+	newEffectTagRule(BanishTag, `\bbanish(es|ed|ing)?\b`, `\bcannot be banished\b`),
+	newEffectTagRule(DrawTag, `\bdraws? [0-9]+ cards?\b`),
+	newEffectTagRule(SearchTag, `\badd\b[^.]*?\bfrom your Deck\b[^.]*?\bto your hand\b`),
+	newEffectTagRule(SpecialSummonTag, `\bSpecial Summon(s|ed|ing)?\b`, `\bcannot be Special Summoned\b`, `\bmust (first )?be Special Summoned\b`),
+	newEffectTagRule(SendToGYTag, `\bsends?\b[^.]*?\bto the GY\b`),
+	newEffectTagRule(OncePerTurnTag, `\bonce per turn\b`),
+	newEffectTagRule(QuickEffectTag, `\(Quick Effect\)`),
+	newEffectTagRule(InflictDamageTag, `\binflicts?\b[^.]*?\bdamage\b`),
+	newEffectTagRule(GainLPTag, `\bgains?\b[^.]*?\bLP\b`),
+}
+
+func newEffectTagRule(tag EffectTag, pattern string, exclusions ...string) effectTagRule {
+	r := effectTagRule{tag: tag, pattern: pattern, regex: regexp.MustCompile("(?i)" + pattern)}
+	for _, exclusion := range exclusions {
+		r.exclusions = append(r.exclusions, regexp.MustCompile("(?i)"+exclusion))
+	}
+	return r
+}
+
+// Every tag that applies to effect, ordered by tag. Spans use rune offsets.
+func TagEffect(effect string) []EffectTagMatch {
+	matches := make([]EffectTagMatch, 0)
+	for _, rule := range effectTagRules {
+		if spans := rule.spans(effect); len(spans) > 0 {
+			matches = append(matches, EffectTagMatch{Tag: rule.tag, Spans: spans})
+		}
+	}
+	return matches
+}
+
+// Case-insensitive pattern that matches every effect with tag. Exclusions are not considered so it can also match effects without tag.
+func EffectTagPattern(tag EffectTag) (string, bool) {
+	for _, rule := range effectTagRules {
+		if rule.tag == tag {
+			return rule.pattern, true
+		}
+	}
+	return "", false
+}
+
+func (r effectTagRule) spans(effect string) []TextSpan {
+	excluded := make([][]int, 0)
+	for _, exclusion := range r.exclusions {
+		excluded = append(excluded, exclusion.FindAllStringIndex(effect, -1)...)
+	}
+
+	spans := make([]TextSpan, 0)
+	for _, loc := range r.regex.FindAllStringIndex(effect, -1) {
+		if !withinAny(loc, excluded) {
+			spans = append(spans, TextSpan{Start: utf8.RuneCountInString(effect[:loc[0]]), End: utf8.RuneCountInString(effect[:loc[1]])})
+		}
+	}
+	return spans
+}
+
+func withinAny(loc []int, ranges [][]int) bool {
+	for _, r := range ranges {
+		if loc[0] >= r[0] && loc[1] <= r[1] {
+			return true
+		}
+	}
+	return false
+}
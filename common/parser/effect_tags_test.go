@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func effectTags(matches []EffectTagMatch) []EffectTag {
+	tags := make([]EffectTag, len(matches))
+	for i, m := range matches {
+		tags[i] = m.Tag
+	}
+	return tags
+}
+
+func TestTagEffect(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName     string
+		effect       string
+		expectedTags []EffectTag
+	}{
+		{testName: "Draw", effect: "Draw 2 cards.", expectedTags: []EffectTag{DrawTag}},
+		{testName: "Draw Phase is not a draw", effect: "During your Draw Phase, you can reveal this card.", expectedTags: []EffectTag{}},
+		{testName: "Search", effect: `Add 1 "Dark Magician" from your Deck to your hand.`, expectedTags: []EffectTag{SearchTag}},
+		{testName: "Adding from GY is not a search", effect: "Add 1 Spell from your GY to your hand.", expectedTags: []EffectTag{}},
+		{testName: "Destroy and banish", effect: "Target 1 card on the field; destroy it, and if you do, banish 1 card from your opponent's GY.", expectedTags: []EffectTag{DestroyTag, BanishTag}},
+		{testName: "Protection is not destruction", effect: "This card cannot be destroyed by battle.", expectedTags: []EffectTag{}},
+		{testName: "Summoning condition is not a Special Summon", effect: "Cannot be Normal Summoned/Set. Must be Special Summoned by its own effect.", expectedTags: []EffectTag{}},
+		{testName: "Special Summon", effect: "You can Special Summon this card from your hand.", expectedTags: []EffectTag{SpecialSummonTag}},
+		{testName: "Send to GY", effect: `Send 1 "Blue-Eyes" monster from your Deck to the GY.`, expectedTags: []EffectTag{SendToGYTag}},
+		{testName: "Burn and recovery", effect: "Inflict 500 damage to your opponent, then you gain 500 LP.", expectedTags: []EffectTag{InflictDamageTag, GainLPTag}},
+		{testName: "Upper case words", effect: "NEGATE the activation.", expectedTags: []EffectTag{NegateTag}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expectedTags, effectTags(TagEffect(tt.effect)))
+		})
+	}
+}
+
+func TestTagEffectSpans(t *testing.T) {
+	assert := assert.New(t)
+	effect := `You can only use this effect of "Ash Blossom & Joyous Spring" once per turn. (Quick Effect): You can discard this card; negate that effect.`
+
+	assert.Equal([]EffectTagMatch{
+		{Tag: NegateTag, Spans: []TextSpan{{Start: 120, End: 126}}},
+		{Tag: OncePerTurnTag, Spans: []TextSpan{{Start: 62, End: 75}}},
+		{Tag: QuickEffectTag, Spans: []TextSpan{{Start: 77, End: 91}}},
+	}, TagEffect(effect))
+
+	tagJSON, _ := json.Marshal(EffectTagMatch{Tag: SpecialSummonTag, Spans: []TextSpan{{Start: 0, End: 14}}})
+	assert.Equal(`{"tag":"SPECIAL_SUMMON","spans":[{"start":0,"end":14}]}`, string(tagJSON))
+}
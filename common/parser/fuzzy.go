@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// lowercases text and strips punctuation so names that differ by a quote, hyphen or casing are considered the same
+func NormalizeForFuzzyMatch(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	pendingSpace := false
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if pendingSpace && sb.Len() > 0 {
+				sb.WriteRune(' ')
+			}
+			pendingSpace = false
+			sb.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '/':
+			pendingSpace = true
+		}
+	}
+	return sb.String()
+}
+
+// unique trigrams of normalized text. Text is padded with a space on each side so short words and word boundaries produce trigrams.
+func Trigrams(normalized string) []string {
+	runes := []rune(" " + normalized + " ")
+	if len(runes) < 3 {
+		return []string{}
+	}
+
+	seen := make(map[string]struct{}, len(runes))
+	trigrams := make([]string, 0, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		trigram := string(runes[i : i+3])
+		if _, exists := seen[trigram]; !exists {
+			seen[trigram] = struct{}{}
+			trigrams = append(trigrams, trigram)
+		}
+	}
+	return trigrams
+}
+
+// minimum number of single rune insertions, deletions or substitutions needed to turn a into b
+func LevenshteinDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	if len(ar) == 0 {
+		return len(br)
+	} else if len(br) == 0 {
+		return len(ar)
+	}
+
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
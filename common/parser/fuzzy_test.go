@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeForFuzzyMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		text     string
+		expected string
+	}{
+		{
+			testName: "Casing is ignored",
+			text:     "Blue-Eyes White Dragon",
+			expected: "blue eyes white dragon",
+		},
+		{
+			testName: "Apostrophes and quotes are removed",
+			text:     `"Magicians' Souls"`,
+			expected: "magicians souls",
+		},
+		{
+			testName: "Repeated and surrounding whitespace is collapsed",
+			text:     "  Dark   Magician ",
+			expected: "dark magician",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, NormalizeForFuzzyMatch(tt.text))
+		})
+	}
+}
+
+func TestTrigrams(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{" ne", "neo", "eos", "os "}, Trigrams("neos"))
+	assert.Equal([]string{" aa", "aaa", "aa "}, Trigrams("aaaa"), "Duplicate trigrams should only be returned once")
+	assert.Equal([]string{}, Trigrams(""))
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		a        string
+		b        string
+		expected int
+	}{
+		{testName: "Identical strings", a: "neos", b: "neos", expected: 0},
+		{testName: "Single substitution", a: "neos", b: "nees", expected: 1},
+		{testName: "Missing rune", a: "magicians souls", b: "magician souls", expected: 1},
+		{testName: "Empty string", a: "", b: "neos", expected: 4},
+		{testName: "Multi byte runes", a: "pokémon", b: "pokemon", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, LevenshteinDistance(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type TunerRequirement int
+
+const (
+	AnyTunerStatus TunerRequirement = iota
+	Tuner
+	NonTuner
+)
+
+// A single component of a material line, ie: 1+ non-Tuner DARK monsters
+type MaterialRequirement struct {
+	Text       string
+	Count      int
+	OrMore     bool
+	Names      []string // any of the names satisfies the requirement
+	Archetype  string
+	Attributes []string
+	Types      []string
+	Frames     []string
+	Tuner      TunerRequirement
+	MinLevel   int  // 0 when there is no lower bound
+	MaxLevel   int  // 0 when there is no upper bound
+	Inclusion  bool // requirement is satisfied by one of the materials from the previous requirements and does not add to the total
+}
+
+type Materials struct {
+	Text         string
+	MinCount     int
+	MaxCount     int // 0 when any number of materials above MinCount can be used
+	Requirements []MaterialRequirement
+}
+
+var (
+	materialCountRegex     = regexp.MustCompile(`^(?:(\d+)(\+| or more)?|an?)\s+`)
+	materialLevelRegex     = regexp.MustCompile(`\bLevel (\d+)(?: or (higher|lower))?\b`)
+	materialNonTunerRegex  = regexp.MustCompile(`\bnon-Tuners?\b`)
+	materialTunerRegex     = regexp.MustCompile(`\bTuners?\b`)
+	materialQuotedRegex    = regexp.MustCompile(`"([^"]+)"`)
+	materialAttributeRegex = regexp.MustCompile(`\b(DARK|LIGHT|EARTH|WATER|FIRE|WIND|DIVINE)\b`)
+	materialFrameRegex     = regexp.MustCompile(`\b(Effect|Fusion|Synchro|Xyz|Link|Normal|Pendulum|Ritual|Gemini|Flip|Toon|Spirit|Union) Monsters?\b`)
+	materialNamesRegex     = regexp.MustCompile(`^"[^"]+"(?:(?:, | or |, or )"[^"]+")*$`)
+
+	// longer Types need to come first so they are matched before shorter Types that are a prefix of them (Beast-Warrior vs Beast)
+	materialTypeRegex = regexp.MustCompile(`\b(Beast-Warrior|Winged Beast|Divine-Beast|Sea Serpent|Creator God|Aqua|Beast|Cyberse|Dinosaur|Dragon|Fairy|Fiend|Fish|Illusion|Insect|Machine|Plant|Psychic|Pyro|Reptile|Rock|Spellcaster|Thunder|Warrior|Wyrm|Zombie)\b`)
+)
+
+// Parses the material line of an extra deck monster (see model.GetPotentialMaterialsAsString). Returns nil if the line does not look like a material list.
+func ParseMaterials(line string) *Materials {
+	line = strings.TrimSpace(line)
+	// material lines are never sentences
+	if line == "" || strings.HasSuffix(line, ".") || strings.Contains(line, "\n") {
+		return nil
+	}
+
+	materials := &Materials{Text: line, Requirements: make([]MaterialRequirement, 0, 3)}
+	openEnded := false
+	for _, component := range strings.Split(line, " + ") {
+		main, inclusion, hasInclusion := strings.Cut(component, ", including ")
+
+		requirement := parseMaterialRequirement(main)
+		if requirement == nil {
+			return nil
+		}
+		materials.Requirements = append(materials.Requirements, *requirement)
+		materials.MinCount += requirement.Count
+		openEnded = openEnded || requirement.OrMore
+
+		if hasInclusion {
+			if included := parseMaterialRequirement(inclusion); included != nil {
+				included.Inclusion = true
+				materials.Requirements = append(materials.Requirements, *included)
+			}
+		}
+	}
+
+	if !openEnded {
+		materials.MaxCount = materials.MinCount
+	}
+	return materials
+}
+
+func parseMaterialRequirement(text string) *MaterialRequirement {
+	text = strings.TrimSpace(text)
+	r := &MaterialRequirement{Text: text, Count: 1}
+
+	remaining := text
+	if match := materialCountRegex.FindStringSubmatch(remaining); match != nil {
+		if match[1] != "" {
+			r.Count, _ = strconv.Atoi(match[1])
+		}
+		r.OrMore = match[2] != ""
+		remaining = remaining[len(match[0]):]
+	}
+
+	// a requirement consisting only of quoted text refers to specific cards, otherwise quoted text is an archetype
+	if materialNamesRegex.MatchString(remaining) {
+		for _, match := range materialQuotedRegex.FindAllStringSubmatch(remaining, -1) {
+			r.Names = append(r.Names, match[1])
+		}
+		return r
+	}
+	if match := materialQuotedRegex.FindStringSubmatch(remaining); match != nil {
+		r.Archetype = match[1]
+		remaining = strings.Replace(remaining, match[0], "", 1)
+	}
+
+	if match := materialLevelRegex.FindStringSubmatch(remaining); match != nil {
+		level, _ := strconv.Atoi(match[1])
+		switch match[2] {
+		case "higher":
+			r.MinLevel = level
+		case "lower":
+			r.MaxLevel = level
+		default:
+			r.MinLevel, r.MaxLevel = level, level
+		}
+	}
+
+	if materialNonTunerRegex.MatchString(remaining) {
+		r.Tuner = NonTuner
+	} else if materialTunerRegex.MatchString(remaining) {
+		r.Tuner = Tuner
+	}
+
+	r.Attributes = allSubmatches(materialAttributeRegex, remaining)
+	r.Types = allSubmatches(materialTypeRegex, remaining)
+	r.Frames = allSubmatches(materialFrameRegex, remaining)
+
+	// every requirement needs to describe a monster
+	if r.Tuner == AnyTunerStatus && !strings.Contains(remaining, "monster") && len(r.Frames) == 0 {
+		return nil
+	}
+	return r
+}
+
+func allSubmatches(re *regexp.Regexp, text string) []string {
+	matches := re.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(matches))
+	for i, match := range matches {
+		values[i] = match[1]
+	}
+	return values
+}
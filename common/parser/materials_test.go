@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaterials(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		line     string
+		expected *Materials
+	}{
+		{
+			testName: "Fusion using named materials",
+			line:     `"Elemental HERO Avian" + "Elemental HERO Burstinatrix"`,
+			expected: &Materials{
+				Text:     `"Elemental HERO Avian" + "Elemental HERO Burstinatrix"`,
+				MinCount: 2,
+				MaxCount: 2,
+				Requirements: []MaterialRequirement{
+					{Text: `"Elemental HERO Avian"`, Count: 1, Names: []string{"Elemental HERO Avian"}},
+					{Text: `"Elemental HERO Burstinatrix"`, Count: 1, Names: []string{"Elemental HERO Burstinatrix"}},
+				},
+			},
+		},
+		{
+			testName: "Fusion using archetype and attribute",
+			line:     `1 "Destiny HERO" monster + 1 DARK Effect Monster`,
+			expected: &Materials{
+				Text:     `1 "Destiny HERO" monster + 1 DARK Effect Monster`,
+				MinCount: 2,
+				MaxCount: 2,
+				Requirements: []MaterialRequirement{
+					{Text: `1 "Destiny HERO" monster`, Count: 1, Archetype: "Destiny HERO"},
+					{Text: "1 DARK Effect Monster", Count: 1, Attributes: []string{"DARK"}, Frames: []string{"Effect"}},
+				},
+			},
+		},
+		{
+			testName: "Synchro with open ended non-Tuners",
+			line:     "1 Tuner + 1+ non-Tuner WIND Winged Beast monsters",
+			expected: &Materials{
+				Text:     "1 Tuner + 1+ non-Tuner WIND Winged Beast monsters",
+				MinCount: 2,
+				Requirements: []MaterialRequirement{
+					{Text: "1 Tuner", Count: 1, Tuner: Tuner},
+					{Text: "1+ non-Tuner WIND Winged Beast monsters", Count: 1, OrMore: true, Tuner: NonTuner, Attributes: []string{"WIND"}, Types: []string{"Winged Beast"}},
+				},
+			},
+		},
+		{
+			testName: "Xyz using Level",
+			line:     "2 Level 4 monsters",
+			expected: &Materials{
+				Text:     "2 Level 4 monsters",
+				MinCount: 2,
+				MaxCount: 2,
+				Requirements: []MaterialRequirement{
+					{Text: "2 Level 4 monsters", Count: 2, MinLevel: 4, MaxLevel: 4},
+				},
+			},
+		},
+		{
+			testName: "Link with inclusion",
+			line:     `2+ monsters, including a "Sky Striker Ace" monster`,
+			expected: &Materials{
+				Text:     `2+ monsters, including a "Sky Striker Ace" monster`,
+				MinCount: 2,
+				Requirements: []MaterialRequirement{
+					{Text: "2+ monsters", Count: 2, OrMore: true},
+					{Text: `a "Sky Striker Ace" monster`, Count: 1, Archetype: "Sky Striker Ace", Inclusion: true},
+				},
+			},
+		},
+		{
+			testName: "Sentence is not a material line",
+			line:     `Must be Special Summoned with "Polymerization".`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, ParseMaterials(tt.line))
+		})
+	}
+}
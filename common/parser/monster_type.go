@@ -0,0 +1,78 @@
+package parser
+
+import "strings"
+
+// Monster type such as Spellcaster/Tuner/Effect separated into the type and flags for each ability and summon frame
+type MonsterType struct {
+	Type string `json:"type"`
+
+	// abilities
+	Tuner  bool `json:"tuner"`
+	Flip   bool `json:"flip"`
+	Gemini bool `json:"gemini"`
+	Spirit bool `json:"spirit"`
+	Union  bool `json:"union"`
+	Toon   bool `json:"toon"`
+
+	// summon frames
+	Normal   bool `json:"normal"`
+	Effect   bool `json:"effect"`
+	Ritual   bool `json:"ritual"`
+	Fusion   bool `json:"fusion"`
+	Synchro  bool `json:"synchro"`
+	Xyz      bool `json:"xyz"`
+	Pendulum bool `json:"pendulum"`
+	Link     bool `json:"link"`
+}
+
+// First token is always the type, remaining tokens can be in any order. Nil if monsterType is empty, ie: Spells and Traps.
+func ParseMonsterType(monsterType string) *MonsterType {
+	tokens := strings.Split(monsterType, "/")
+	if strings.TrimSpace(tokens[0]) == "" {
+		return nil
+	}
+
+	m := &MonsterType{Type: strings.TrimSpace(tokens[0])}
+	for _, token := range tokens[1:] {
+		if flag := m.flag(strings.TrimSpace(token)); flag != nil {
+			*flag = true
+		}
+	}
+	return m
+}
+
+// pointer to the field that represents token, nil for unknown tokens
+func (m *MonsterType) flag(token string) *bool {
+	switch strings.ToLower(token) {
+	case "tuner":
+		return &m.Tuner
+	case "flip":
+		return &m.Flip
+	case "gemini":
+		return &m.Gemini
+	case "spirit":
+		return &m.Spirit
+	case "union":
+		return &m.Union
+	case "toon":
+		return &m.Toon
+	case "normal":
+		return &m.Normal
+	case "effect":
+		return &m.Effect
+	case "ritual":
+		return &m.Ritual
+	case "fusion":
+		return &m.Fusion
+	case "synchro":
+		return &m.Synchro
+	case "xyz":
+		return &m.Xyz
+	case "pendulum":
+		return &m.Pendulum
+	case "link":
+		return &m.Link
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMonsterType(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName    string
+		monsterType string
+		expected    *MonsterType
+	}{
+		{testName: "Normal monster", monsterType: "Dragon/Normal", expected: &MonsterType{Type: "Dragon", Normal: true}},
+		{testName: "Tuner", monsterType: "Spellcaster/Tuner/Effect", expected: &MonsterType{Type: "Spellcaster", Tuner: true, Effect: true}},
+		{testName: "Extra deck pendulum", monsterType: "Dragon/Fusion/Pendulum/Effect", expected: &MonsterType{Type: "Dragon", Fusion: true, Pendulum: true, Effect: true}},
+		{testName: "Ability", monsterType: "Fairy/Spirit/Effect", expected: &MonsterType{Type: "Fairy", Spirit: true, Effect: true}},
+		{testName: "Multiple abilities", monsterType: "Insect/Flip/Tuner/Effect", expected: &MonsterType{Type: "Insect", Flip: true, Tuner: true, Effect: true}},
+		{testName: "Link", monsterType: "Cyberse/Link/Effect", expected: &MonsterType{Type: "Cyberse", Link: true, Effect: true}},
+		{testName: "Spaces and unknown tokens", monsterType: " Beast-Warrior / Xyz / Something /Effect", expected: &MonsterType{Type: "Beast-Warrior", Xyz: true, Effect: true}},
+		{testName: "Only type", monsterType: "Zombie", expected: &MonsterType{Type: "Zombie"}},
+		{testName: "Empty", monsterType: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, ParseMonsterType(tt.monsterType))
+		})
+	}
+}
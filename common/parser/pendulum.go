@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Pendulum card text separated into the pendulum zone and monster zone sections
+type PendulumEffect struct {
+	Scale          *uint32
+	PendulumEffect string
+	MonsterEffect  string
+}
+
+var (
+	pendulumSectionRegex = regexp.MustCompile(`\n\n(?:Monster Effect|Flavor Text)\n`)
+	pendulumHeaderRegex  = regexp.MustCompile(`^\s*(?:\[\s*)?Pendulum Effect(?:\s*\])?\s*\n`)
+	pendulumScaleRegex   = regexp.MustCompile(`^\s*(?:\[\s*)?(?:Pendulum )?Scale\s*[:=]?\s*(\d+)(?:\s*\])?\s*\n?`)
+)
+
+// Splits effect of a Pendulum card. Returns nil if effect does not contain a monster section.
+func SplitPendulumEffect(effect string) *PendulumEffect {
+	loc := pendulumSectionRegex.FindStringIndex(effect)
+	if loc == nil {
+		return nil
+	}
+
+	p := &PendulumEffect{MonsterEffect: strings.TrimSpace(effect[loc[1]:])}
+
+	pendulumSection := effect[:loc[0]]
+	if match := pendulumScaleRegex.FindStringSubmatch(pendulumSection); match != nil {
+		if scale, err := strconv.ParseUint(match[1], 10, 32); err == nil {
+			s := uint32(scale)
+			p.Scale = &s
+		}
+		pendulumSection = pendulumSection[len(match[0]):]
+	}
+	pendulumSection = pendulumHeaderRegex.ReplaceAllString(pendulumSection, "")
+
+	p.PendulumEffect = strings.TrimSpace(pendulumSection)
+	return p
+}
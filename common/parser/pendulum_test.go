@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPendulumEffect(t *testing.T) {
+	assert := assert.New(t)
+	scale := uint32(4)
+
+	tests := []struct {
+		testName string
+		effect   string
+		expected *PendulumEffect
+	}{
+		{
+			testName: "Pendulum effect header is removed",
+			effect:   "Pendulum Effect\nOnce per turn: You can target 1 card.\n\nMonster Effect\nIf this card is Normal Summoned: Draw 1 card.",
+			expected: &PendulumEffect{PendulumEffect: "Once per turn: You can target 1 card.", MonsterEffect: "If this card is Normal Summoned: Draw 1 card."},
+		},
+		{
+			testName: "Scale is extracted when present",
+			effect:   "Pendulum Scale: 4\nPendulum Effect\nOnce per turn: You can target 1 card.\n\nMonster Effect\n2 Level 4 monsters\nDraw 1 card.",
+			expected: &PendulumEffect{Scale: &scale, PendulumEffect: "Once per turn: You can target 1 card.", MonsterEffect: "2 Level 4 monsters\nDraw 1 card."},
+		},
+		{
+			testName: "Pendulum Normal monsters use flavor text",
+			effect:   "Pendulum Effect\nYou can destroy this card.\n\nFlavor Text\nA dragon of legend.",
+			expected: &PendulumEffect{PendulumEffect: "You can destroy this card.", MonsterEffect: "A dragon of legend."},
+		},
+		{
+			testName: "Empty pendulum section",
+			effect:   "\n\nMonster Effect\nIf this card is Normal Summoned: Draw 1 card.",
+			expected: &PendulumEffect{PendulumEffect: "", MonsterEffect: "If this card is Normal Summoned: Draw 1 card."},
+		},
+		{
+			testName: "Non Pendulum text",
+			effect:   "Draw 2 cards.",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, SplitPendulumEffect(tt.effect))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Code printed on a card, ie: LOB-EN001 is the 1st card of product LOB printed using the EN locale
+type PrintCode struct {
+	ProductID string
+	Locale    string // empty for codes printed before locales were part of the code, ie: LOB-001. Legacy one letter locales are converted, ie: SDY-E005 uses EN
+	Position  string
+}
+
+var (
+	printCodeRegex = regexp.MustCompile(`^([A-Z0-9]{2,5})-([A-Z]{1,2})?([0-9]{1,4})$`)
+
+	ErrInvalidPrintCode = errors.New("print code should look like LOB-EN001")
+
+	// early TCG products used a single letter for the locale
+	legacyLocales = map[string]string{"E": "EN", "F": "FR", "G": "DE", "I": "IT", "S": "SP", "P": "PT"}
+)
+
+// Case insensitive, surrounding whitespace is ignored
+func ParsePrintCode(code string) (*PrintCode, error) {
+	match := printCodeRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(code)))
+	if match == nil {
+		return nil, ErrInvalidPrintCode
+	}
+
+	locale := match[2]
+	if len(locale) == 1 {
+		if locale = legacyLocales[locale]; locale == "" {
+			return nil, ErrInvalidPrintCode
+		}
+	}
+	return &PrintCode{ProductID: match[1], Locale: locale, Position: match[3]}, nil
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrintCode(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		code     string
+		expected *PrintCode
+	}{
+		{code: "LOB-EN001", expected: &PrintCode{ProductID: "LOB", Locale: "EN", Position: "001"}},
+		{code: " ct13-en003 ", expected: &PrintCode{ProductID: "CT13", Locale: "EN", Position: "003"}},
+		{code: "SDY-E005", expected: &PrintCode{ProductID: "SDY", Locale: "EN", Position: "005"}},
+		{code: "MRD-G060", expected: &PrintCode{ProductID: "MRD", Locale: "DE", Position: "060"}},
+		{code: "LOB-S001", expected: &PrintCode{ProductID: "LOB", Locale: "SP", Position: "001"}},
+		{code: "LOB-X001", expected: nil},
+		{code: "LOB-001", expected: &PrintCode{ProductID: "LOB", Position: "001"}},
+		{code: "89631139", expected: nil},
+		{code: "LOB-EN", expected: nil},
+		{code: "LOB-EN001; DROP TABLE products", expected: nil},
+		{code: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			p, err := ParsePrintCode(tt.code)
+			assert.Equal(tt.expected, p)
+			if tt.expected == nil {
+				assert.ErrorIs(err, ErrInvalidPrintCode)
+			}
+		})
+	}
+}
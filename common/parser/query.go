@@ -0,0 +1,394 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type QueryField int
+
+const (
+	EffectField QueryField = iota
+	NameField
+)
+
+type QueryNodeType int
+
+const (
+	TermNode QueryNodeType = iota
+	AndNode
+	OrNode
+	NotNode
+)
+
+// Parsed version of a card text query. Terms use Field, Words, Phrase and Prefix while other nodes only use Children.
+type QueryNode struct {
+	Type     QueryNodeType
+	Children []*QueryNode
+
+	Field  QueryField
+	Text   string   // term as written by user, minus quotes and wildcard
+	Words  []string // words of the term as understood by full text search
+	Phrase bool
+	Prefix bool
+}
+
+const (
+	maxQueryTerms = 20
+)
+
+var (
+	queryFields = map[string]QueryField{"name": NameField, "effect": EffectField}
+
+	ErrEmptyQuery = errors.New("query does not contain any terms")
+)
+
+/*
+Parses queries such as banish AND "face-down" NOT name:"Dark Magician". Supported syntax:
+  - AND, OR and NOT (upper case only). Terms without an operator between them use AND. AND takes precedence over OR.
+  - parentheses for grouping
+  - double quotes for phrases
+  - trailing * for prefix matching
+  - name: and effect: to scope a term, phrase or group to a field. Effect is used by default.
+*/
+func ParseQuery(query string) (*QueryNode, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens}
+	root, err := p.parseOr(EffectField)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	if root == nil {
+		return nil, ErrEmptyQuery
+	}
+	if p.terms > maxQueryTerms {
+		return nil, fmt.Errorf("query cannot contain more than %d terms", maxQueryTerms)
+	}
+	if !root.hasPositiveMatch() {
+		return nil, errors.New("query needs at least one term that is not negated")
+	}
+	return root, nil
+}
+
+// Term in the syntax used by MySQL full text search IN BOOLEAN MODE. Words only contain letters, digits, underscores and apostrophes
+// so the result never contains boolean mode operators other than the ones added here.
+func (n *QueryNode) BooleanModeTerm() string {
+	switch {
+	case n.Phrase || len(n.Words) > 1:
+		return `"` + strings.Join(n.Words, " ") + `"`
+	case n.Prefix:
+		return n.Words[0] + "*"
+	default:
+		return n.Words[0]
+	}
+}
+
+// terms that need to match for a card to be part of the results, ie: terms that are not negated
+func (n *QueryNode) PositiveTerms() []*QueryNode {
+	terms := make([]*QueryNode, 0)
+	n.collectPositiveTerms(&terms)
+	return terms
+}
+
+func (n *QueryNode) collectPositiveTerms(terms *[]*QueryNode) {
+	switch n.Type {
+	case TermNode:
+		*terms = append(*terms, n)
+	case AndNode, OrNode:
+		for _, child := range n.Children {
+			child.collectPositiveTerms(terms)
+		}
+	}
+}
+
+// Location of terms that are not negated in effect. Phrases are usually card names, quoted occurrences are preferred for them.
+func (n *QueryNode) EffectSpans(effect string) []TextSpan {
+	spans := make([]TextSpan, 0)
+	for _, term := range n.PositiveTerms() {
+		if term.Field != EffectField {
+			continue
+		}
+
+		termSpans := make([]TextSpan, 0)
+		if term.Phrase {
+			termSpans = QuotedSubStrSpans(effect, term.Text)
+		}
+		if len(termSpans) == 0 {
+			termSpans = SubStrSpans(effect, term.Text)
+		}
+		spans = append(spans, termSpans...)
+	}
+	return MergeSpans(spans)
+}
+
+// a query made up of only negated terms would need to look at every card
+func (n *QueryNode) hasPositiveMatch() bool {
+	switch n.Type {
+	case TermNode:
+		return true
+	case AndNode:
+		for _, child := range n.Children {
+			if child.hasPositiveMatch() {
+				return true
+			}
+		}
+		return false
+	case OrNode:
+		for _, child := range n.Children {
+			if !child.hasPositiveMatch() {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+type queryTokenType int
+
+const (
+	wordToken queryTokenType = iota
+	phraseToken
+	fieldToken
+	andToken
+	orToken
+	notToken
+	openToken
+	closeToken
+)
+
+type queryToken struct {
+	t     queryTokenType
+	value string
+}
+
+func (t queryToken) String() string {
+	switch t.t {
+	case phraseToken:
+		return fmt.Sprintf(`phrase "%s"`, t.value)
+	case fieldToken:
+		return fmt.Sprintf("field %s:", t.value)
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	runes := []rune(query)
+	tokens := make([]queryToken, 0)
+
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{t: openToken, value: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{t: closeToken, value: ")"})
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.New("phrase is missing closing quote")
+			}
+			tokens = append(tokens, queryToken{t: phraseToken, value: string(runes[i+1 : end])})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()"`, runes[end]) {
+				end++
+			}
+			tokens = append(tokens, wordTokens(string(runes[i:end]))...)
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+func wordTokens(word string) []queryToken {
+	switch word {
+	case "AND":
+		return []queryToken{{t: andToken, value: word}}
+	case "OR":
+		return []queryToken{{t: orToken, value: word}}
+	case "NOT":
+		return []queryToken{{t: notToken, value: word}}
+	}
+
+	if field, rest, hasField := strings.Cut(word, ":"); hasField {
+		if _, isField := queryFields[strings.ToLower(field)]; isField {
+			tokens := []queryToken{{t: fieldToken, value: strings.ToLower(field)}}
+			if rest != "" {
+				tokens = append(tokens, queryToken{t: wordToken, value: rest})
+			}
+			return tokens
+		}
+	}
+	return []queryToken{{t: wordToken, value: word}}
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+	terms  int
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *queryParser) parseOr(field QueryField) (*QueryNode, error) {
+	children := make([]*QueryNode, 0, 2)
+	for {
+		child, err := p.parseAnd(field)
+		if err != nil {
+			return nil, err
+		}
+
+		t, exists := p.peek()
+		hasOr := exists && t.t == orToken
+		if child == nil && (hasOr || len(children) != 0) {
+			return nil, errors.New("OR needs a term on both sides")
+		} else if child != nil {
+			children = append(children, child)
+		}
+
+		if !hasOr {
+			break
+		}
+		p.pos++
+	}
+	return combine(OrNode, children), nil
+}
+
+func (p *queryParser) parseAnd(field QueryField) (*QueryNode, error) {
+	children := make([]*QueryNode, 0, 2)
+	for {
+		t, exists := p.peek()
+		if !exists || t.t == orToken || t.t == closeToken {
+			break
+		}
+		if t.t == andToken {
+			if len(children) == 0 {
+				return nil, errors.New("AND needs a term on both sides")
+			}
+			p.pos++
+			if next, exists := p.peek(); !exists || next.t == orToken || next.t == closeToken || next.t == andToken {
+				return nil, errors.New("AND needs a term on both sides")
+			}
+			continue
+		}
+
+		child, err := p.parseUnary(field)
+		if err != nil {
+			return nil, err
+		}
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	return combine(AndNode, children), nil
+}
+
+func (p *queryParser) parseUnary(field QueryField) (*QueryNode, error) {
+	t, _ := p.peek()
+	if t.t != notToken {
+		return p.parsePrimary(field)
+	}
+
+	p.pos++
+	if next, exists := p.peek(); !exists || next.t == andToken || next.t == orToken || next.t == closeToken {
+		return nil, errors.New("NOT needs a term")
+	}
+	child, err := p.parseUnary(field)
+	if err != nil || child == nil {
+		return nil, err
+	}
+	return &QueryNode{Type: NotNode, Children: []*QueryNode{child}}, nil
+}
+
+// nil node is returned for terms that do not contain any searchable characters
+func (p *queryParser) parsePrimary(field QueryField) (*QueryNode, error) {
+	t := p.tokens[p.pos]
+	p.pos++
+
+	switch t.t {
+	case fieldToken:
+		next, exists := p.peek()
+		if !exists || (next.t != wordToken && next.t != phraseToken && next.t != openToken) {
+			return nil, fmt.Errorf("%s needs a term", t)
+		}
+		return p.parsePrimary(queryFields[t.value])
+	case openToken:
+		group, err := p.parseOr(field)
+		if err != nil {
+			return nil, err
+		}
+		if closing, exists := p.peek(); !exists || closing.t != closeToken {
+			return nil, errors.New("group is missing closing parenthesis")
+		}
+		p.pos++
+		return group, nil
+	case closeToken:
+		return nil, errors.New("unexpected closing parenthesis")
+	case phraseToken:
+		return p.newTerm(field, t.value, true, false), nil
+	default:
+		prefix := strings.HasSuffix(t.value, "*")
+		return p.newTerm(field, strings.TrimRight(t.value, "*"), false, prefix), nil
+	}
+}
+
+func (p *queryParser) newTerm(field QueryField, text string, isPhrase, isPrefix bool) *QueryNode {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '\''
+	})
+	for i := range words {
+		words[i] = strings.Trim(words[i], "'")
+	}
+	words = removeEmpty(words)
+	if len(words) == 0 {
+		return nil
+	}
+
+	p.terms++
+	return &QueryNode{Type: TermNode, Field: field, Text: strings.TrimSpace(text), Words: words, Phrase: isPhrase, Prefix: isPrefix && !isPhrase && len(words) == 1}
+}
+
+func removeEmpty(words []string) []string {
+	nonEmpty := words[:0]
+	for _, w := range words {
+		if w != "" {
+			nonEmpty = append(nonEmpty, w)
+		}
+	}
+	return nonEmpty
+}
+
+func combine(t QueryNodeType, children []*QueryNode) *QueryNode {
+	switch len(children) {
+	case 0:
+		return nil
+	case 1:
+		return children[0]
+	default:
+		return &QueryNode{Type: t, Children: children}
+	}
+}
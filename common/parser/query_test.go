@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// compact representation of a query tree used to compare results
+func describeQuery(n *QueryNode) string {
+	switch n.Type {
+	case TermNode:
+		field := "effect"
+		if n.Field == NameField {
+			field = "name"
+		}
+		return field + ":" + n.BooleanModeTerm()
+	case NotNode:
+		return "NOT(" + describeQuery(n.Children[0]) + ")"
+	default:
+		op := "AND"
+		if n.Type == OrNode {
+			op = "OR"
+		}
+		children := make([]string, len(n.Children))
+		for i, child := range n.Children {
+			children[i] = describeQuery(child)
+		}
+		return op + "(" + strings.Join(children, " ") + ")"
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		query    string
+		expected string
+	}{
+		{testName: "Single word", query: "banish", expected: "effect:banish"},
+		{testName: "Implicit AND", query: "banish draw", expected: "AND(effect:banish effect:draw)"},
+		{testName: "Explicit AND", query: "banish AND draw", expected: "AND(effect:banish effect:draw)"},
+		{testName: "OR", query: "banish OR draw", expected: "OR(effect:banish effect:draw)"},
+		{testName: "AND takes precedence over OR", query: "a1 b1 OR c1", expected: "OR(AND(effect:a1 effect:b1) effect:c1)"},
+		{testName: "Group", query: "a1 (b1 OR c1)", expected: "AND(effect:a1 OR(effect:b1 effect:c1))"},
+		{testName: "NOT", query: `banish AND "face-down" NOT "Graveyard"`, expected: `AND(effect:banish effect:"face down" NOT(effect:"Graveyard"))`},
+		{testName: "Prefix", query: "destr*", expected: "effect:destr*"},
+		{testName: "Hyphenated word becomes phrase", query: "face-down", expected: `effect:"face down"`},
+		{testName: "Apostrophe is kept", query: "opponent's", expected: "effect:opponent's"},
+		{testName: "Name field", query: `name:"Dark Magician" spellcaster`, expected: `AND(name:"Dark Magician" effect:spellcaster)`},
+		{testName: "Field scoped group", query: "name:(hero OR neos)", expected: "OR(name:hero name:neos)"},
+		{testName: "Unknown field is part of word", query: "Utopia: attack", expected: "AND(effect:Utopia effect:attack)"},
+		{testName: "Lower case operators are words", query: "banish or draw", expected: "AND(effect:banish effect:or effect:draw)"},
+		{testName: "Boolean mode operators are removed", query: `+banish -draw ~hand <deck> @2`, expected: "AND(effect:banish effect:draw effect:hand effect:deck effect:2)"},
+		{testName: "Terms without searchable characters are ignored", query: "banish ***", expected: "effect:banish"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.Nil(err)
+			assert.Equal(tt.expected, describeQuery(q))
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		query    string
+	}{
+		{testName: "Empty", query: "   "},
+		{testName: "Only symbols", query: "+-*"},
+		{testName: "Unterminated phrase", query: `"face-down`},
+		{testName: "Unclosed group", query: "(banish OR draw"},
+		{testName: "Unopened group", query: "banish) draw"},
+		{testName: "Dangling AND", query: "banish AND"},
+		{testName: "Leading AND", query: "AND banish"},
+		{testName: "Dangling OR", query: "banish OR"},
+		{testName: "Leading OR", query: "OR banish"},
+		{testName: "Dangling NOT", query: "banish NOT"},
+		{testName: "NOT before operator", query: "banish NOT OR draw"},
+		{testName: "Only negated", query: "NOT banish"},
+		{testName: "OR w/ negated side", query: "banish OR NOT draw"},
+		{testName: "Field without term", query: "name:"},
+		{testName: "Too many terms", query: strings.Repeat("banish ", maxQueryTerms+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			assert.Nil(q)
+			assert.NotNil(err)
+		})
+	}
+}
+
+func TestPositiveTerms(t *testing.T) {
+	assert := assert.New(t)
+
+	q, err := ParseQuery(`banish NOT draw (name:hero OR "face-down")`)
+	assert.Nil(err)
+
+	terms := q.PositiveTerms()
+	texts := make([]string, len(terms))
+	for i, term := range terms {
+		texts[i] = term.Text
+	}
+	assert.Equal([]string{"banish", "hero", "face-down"}, texts)
+}
+
+func TestEffectSpans(t *testing.T) {
+	assert := assert.New(t)
+	effect := `Banish 1 "Neos" you control face-down, then draw. "Neos Fusion" can be banished.`
+
+	tests := []struct {
+		testName      string
+		query         string
+		expectedSpans []TextSpan
+	}{
+		{testName: "Word matches every occurrence", query: "banish", expectedSpans: []TextSpan{{Start: 0, End: 6}, {Start: 71, End: 77}}},
+		{testName: "Quoted phrase is preferred", query: `"Neos"`, expectedSpans: []TextSpan{{Start: 10, End: 14}}},
+		{testName: "Unquoted phrase", query: `"face-down"`, expectedSpans: []TextSpan{{Start: 28, End: 37}}},
+		{testName: "Negated and name terms are ignored", query: `draw NOT banish name:Neos`, expectedSpans: []TextSpan{{Start: 44, End: 48}}},
+		{testName: "Overlapping spans are merged", query: `"Neos Fusion" OR Fusion`, expectedSpans: []TextSpan{{Start: 51, End: 62}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			if assert.Nil(err) {
+				assert.Equal(tt.expectedSpans, q.EffectSpans(effect))
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package parser
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 func TextContainsSubStr(text, substring string) bool {
 	if occurrences := OccurrencesOfQuotedSubStr(text, substring, true); occurrences == 1 {
@@ -10,12 +13,27 @@ func TextContainsSubStr(text, substring string) bool {
 }
 
 func OccurrencesOfQuotedSubStr(text, substring string, exitOnFirstOccurrence bool) int {
+	return len(quotedSubStrSpans(text, substring, exitOnFirstOccurrence))
+}
+
+// Location of text using rune offsets, end is exclusive
+type TextSpan struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// every occurrence of substring wrapped in single or double quotes, spans exclude the quotes
+func QuotedSubStrSpans(text, substring string) []TextSpan {
+	return quotedSubStrSpans(text, substring, false)
+}
+
+func quotedSubStrSpans(text, substring string, exitOnFirstOccurrence bool) []TextSpan {
 	runes := []rune(text)
 	nameRunes := []rune(substring)
 	textLen := len(runes)
 	nameLen := len(nameRunes)
 
-	occurrences := 0
+	spans := make([]TextSpan, 0)
 
 	for i := 0; i < textLen; i++ {
 		if runes[i] == '"' || runes[i] == '\'' {
@@ -31,16 +49,50 @@ func OccurrencesOfQuotedSubStr(text, substring string, exitOnFirstOccurrence boo
 			}
 
 			if string(runes[start:end]) == substring {
+				spans = append(spans, TextSpan{Start: start, End: end})
 				if exitOnFirstOccurrence {
-					return 1
+					return spans
 				}
 
 				i = end
-				occurrences++
 			}
 		}
 	}
-	return occurrences
+	return spans
+}
+
+// every case-insensitive occurrence of substring, quoted or not
+func SubStrSpans(text, substring string) []TextSpan {
+	runes := []rune(strings.ToLower(text))
+	subRunes := []rune(strings.ToLower(substring))
+	spans := make([]TextSpan, 0)
+	if len(subRunes) == 0 || len(runes) != len([]rune(text)) {
+		return spans // lower casing changed the number of runes, offsets would not line up with text
+	}
+
+	for i := 0; i+len(subRunes) <= len(runes); i++ {
+		if slices.Equal(runes[i:i+len(subRunes)], subRunes) {
+			spans = append(spans, TextSpan{Start: i, End: i + len(subRunes)})
+			i += len(subRunes) - 1
+		}
+	}
+	return spans
+}
+
+// sorts spans and combines the ones that overlap or touch
+func MergeSpans(spans []TextSpan) []TextSpan {
+	sorted := slices.Clone(spans)
+	slices.SortFunc(sorted, func(a, b TextSpan) int { return a.Start - b.Start })
+
+	merged := make([]TextSpan, 0, len(sorted))
+	for _, span := range sorted {
+		if last := len(merged) - 1; last >= 0 && span.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, span.End)
+		} else {
+			merged = append(merged, span)
+		}
+	}
+	return merged
 }
 
 type QuotedToken = string
@@ -56,3 +108,20 @@ func CleanupToken(t *QuotedToken) {
 	*t = strings.Trim(*t, "'")
 	*t = strings.Trim(*t, `"`)
 }
+
+// unique text found between double quotes, ie: names of cards or archetypes referenced in an effect. Unterminated quotes are ignored.
+func QuotedTokens(text string) []QuotedToken {
+	parts := strings.Split(text, `"`)
+	tokens := make([]QuotedToken, 0, len(parts)/2)
+	seen := make(map[QuotedToken]struct{}, len(parts)/2)
+
+	for i := 1; i < len(parts)-1; i += 2 {
+		token := parts[i]
+		CleanupToken(&token)
+		if _, exists := seen[token]; token != "" && !exists {
+			seen[token] = struct{}{}
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
@@ -87,3 +87,92 @@ func TestCleanupToken(t *testing.T) {
 	CleanupToken(&edge1)
 	assert.Equal("Magicians' Souls", edge1, "Edge case 1 (inner single quote should not be removed) - failed")
 }
+
+func TestQuotedTokens(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName       string
+		text           string
+		expectedTokens []QuotedToken
+	}{
+		{
+			testName:       "Unique tokens in order of appearance",
+			text:           `Add 1 "Neos" or 1 "Neo-Spacian" monster from your Deck to your hand. You can only use this effect of "Neos" once per turn.`,
+			expectedTokens: []QuotedToken{"Neos", "Neo-Spacian"},
+		},
+		{
+			testName:       "Unterminated quote is ignored",
+			text:           `Add 1 "Neos" from your Deck to your hand. "Neo`,
+			expectedTokens: []QuotedToken{"Neos"},
+		},
+		{
+			testName:       "Single quotes are not treated as tokens",
+			text:           `Add 1 "Magicians' Souls" to your hand.`,
+			expectedTokens: []QuotedToken{"Magicians' Souls"},
+		},
+		{
+			testName:       "No quotes",
+			text:           "Draw 2 cards.",
+			expectedTokens: []QuotedToken{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expectedTokens, QuotedTokens(tt.text))
+		})
+	}
+}
+
+func TestQuotedSubStrSpans(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName      string
+		text          string
+		substring     string
+		expectedSpans []TextSpan
+	}{
+		{
+			testName:      "Two complete quotes",
+			text:          `"Neos" is a powerful monster from and his name is "Neos"`,
+			substring:     "Neos",
+			expectedSpans: []TextSpan{{Start: 1, End: 5}, {Start: 51, End: 55}},
+		},
+		{
+			testName:      "Offsets use runes",
+			text:          `★ Add 1 "Neos" to your hand.`,
+			substring:     "Neos",
+			expectedSpans: []TextSpan{{Start: 9, End: 13}},
+		},
+		{
+			testName:      "Quoted strings in text does not match name",
+			text:          `"Neos2" is a powerful monster`,
+			substring:     "Neos",
+			expectedSpans: []TextSpan{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expectedSpans, QuotedSubStrSpans(tt.text, tt.substring))
+		})
+	}
+}
+
+func TestSubStrSpans(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]TextSpan{{Start: 0, End: 6}, {Start: 20, End: 26}}, SubStrSpans("Banish 1 card, then banish it.", "banish"))
+	assert.Equal([]TextSpan{{Start: 2, End: 6}}, SubStrSpans("★ draw", "DRAW"))
+	assert.Equal([]TextSpan{}, SubStrSpans("Draw 1 card.", ""))
+}
+
+func TestMergeSpans(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]TextSpan{{Start: 0, End: 8}, {Start: 10, End: 12}},
+		MergeSpans([]TextSpan{{Start: 10, End: 12}, {Start: 4, End: 8}, {Start: 0, End: 5}}))
+	assert.Equal([]TextSpan{}, MergeSpans([]TextSpan{}))
+}
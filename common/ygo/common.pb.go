@@ -67,6 +67,542 @@ func (CardRestrictionSortOrder) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{0}
 }
 
+type CardSortOrder int32
+
+const (
+	CardSortOrder_NAME_ASC              CardSortOrder = 0
+	CardSortOrder_COLOR_ASC_NAME_ASC    CardSortOrder = 1
+	CardSortOrder_ATTACK_DESC_NAME_ASC  CardSortOrder = 2
+	CardSortOrder_DEFENSE_DESC_NAME_ASC CardSortOrder = 3
+)
+
+// Enum value maps for CardSortOrder.
+var (
+	CardSortOrder_name = map[int32]string{
+		0: "NAME_ASC",
+		1: "COLOR_ASC_NAME_ASC",
+		2: "ATTACK_DESC_NAME_ASC",
+		3: "DEFENSE_DESC_NAME_ASC",
+	}
+	CardSortOrder_value = map[string]int32{
+		"NAME_ASC":              0,
+		"COLOR_ASC_NAME_ASC":    1,
+		"ATTACK_DESC_NAME_ASC":  2,
+		"DEFENSE_DESC_NAME_ASC": 3,
+	}
+)
+
+func (x CardSortOrder) Enum() *CardSortOrder {
+	p := new(CardSortOrder)
+	*p = x
+	return p
+}
+
+func (x CardSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[1].Descriptor()
+}
+
+func (CardSortOrder) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[1]
+}
+
+func (x CardSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardSortOrder.Descriptor instead.
+func (CardSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+type TunerRequirement int32
+
+const (
+	TunerRequirement_MATERIAL_ANY       TunerRequirement = 0
+	TunerRequirement_MATERIAL_TUNER     TunerRequirement = 1
+	TunerRequirement_MATERIAL_NON_TUNER TunerRequirement = 2
+)
+
+// Enum value maps for TunerRequirement.
+var (
+	TunerRequirement_name = map[int32]string{
+		0: "MATERIAL_ANY",
+		1: "MATERIAL_TUNER",
+		2: "MATERIAL_NON_TUNER",
+	}
+	TunerRequirement_value = map[string]int32{
+		"MATERIAL_ANY":       0,
+		"MATERIAL_TUNER":     1,
+		"MATERIAL_NON_TUNER": 2,
+	}
+)
+
+func (x TunerRequirement) Enum() *TunerRequirement {
+	p := new(TunerRequirement)
+	*p = x
+	return p
+}
+
+func (x TunerRequirement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunerRequirement) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[2].Descriptor()
+}
+
+func (TunerRequirement) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[2]
+}
+
+func (x TunerRequirement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunerRequirement.Descriptor instead.
+func (TunerRequirement) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
+type CardReferenceType int32
+
+const (
+	CardReferenceType_MENTIONS     CardReferenceType = 0
+	CardReferenceType_MENTIONED_BY CardReferenceType = 1
+	CardReferenceType_ARCHETYPE    CardReferenceType = 2
+)
+
+// Enum value maps for CardReferenceType.
+var (
+	CardReferenceType_name = map[int32]string{
+		0: "MENTIONS",
+		1: "MENTIONED_BY",
+		2: "ARCHETYPE",
+	}
+	CardReferenceType_value = map[string]int32{
+		"MENTIONS":     0,
+		"MENTIONED_BY": 1,
+		"ARCHETYPE":    2,
+	}
+)
+
+func (x CardReferenceType) Enum() *CardReferenceType {
+	p := new(CardReferenceType)
+	*p = x
+	return p
+}
+
+func (x CardReferenceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardReferenceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[3].Descriptor()
+}
+
+func (CardReferenceType) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[3]
+}
+
+func (x CardReferenceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardReferenceType.Descriptor instead.
+func (CardReferenceType) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{3}
+}
+
+type ArchetypeInclusionReason int32
+
+const (
+	ArchetypeInclusionReason_NAME_MATCH        ArchetypeInclusionReason = 0
+	ArchetypeInclusionReason_ALWAYS_TREATED_AS ArchetypeInclusionReason = 1
+)
+
+// Enum value maps for ArchetypeInclusionReason.
+var (
+	ArchetypeInclusionReason_name = map[int32]string{
+		0: "NAME_MATCH",
+		1: "ALWAYS_TREATED_AS",
+	}
+	ArchetypeInclusionReason_value = map[string]int32{
+		"NAME_MATCH":        0,
+		"ALWAYS_TREATED_AS": 1,
+	}
+)
+
+func (x ArchetypeInclusionReason) Enum() *ArchetypeInclusionReason {
+	p := new(ArchetypeInclusionReason)
+	*p = x
+	return p
+}
+
+func (x ArchetypeInclusionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchetypeInclusionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[4].Descriptor()
+}
+
+func (ArchetypeInclusionReason) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[4]
+}
+
+func (x ArchetypeInclusionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchetypeInclusionReason.Descriptor instead.
+func (ArchetypeInclusionReason) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+type DeckLocation int32
+
+const (
+	DeckLocation_MAIN_DECK  DeckLocation = 0
+	DeckLocation_EXTRA_DECK DeckLocation = 1
+	DeckLocation_NO_DECK    DeckLocation = 2
+)
+
+// Enum value maps for DeckLocation.
+var (
+	DeckLocation_name = map[int32]string{
+		0: "MAIN_DECK",
+		1: "EXTRA_DECK",
+		2: "NO_DECK",
+	}
+	DeckLocation_value = map[string]int32{
+		"MAIN_DECK":  0,
+		"EXTRA_DECK": 1,
+		"NO_DECK":    2,
+	}
+)
+
+func (x DeckLocation) Enum() *DeckLocation {
+	p := new(DeckLocation)
+	*p = x
+	return p
+}
+
+func (x DeckLocation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeckLocation) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[5].Descriptor()
+}
+
+func (DeckLocation) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[5]
+}
+
+func (x DeckLocation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeckLocation.Descriptor instead.
+func (DeckLocation) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{5}
+}
+
+type CardCategory int32
+
+const (
+	CardCategory_MONSTER CardCategory = 0
+	CardCategory_SPELL   CardCategory = 1
+	CardCategory_TRAP    CardCategory = 2
+	CardCategory_TOKEN   CardCategory = 3
+)
+
+// Enum value maps for CardCategory.
+var (
+	CardCategory_name = map[int32]string{
+		0: "MONSTER",
+		1: "SPELL",
+		2: "TRAP",
+		3: "TOKEN",
+	}
+	CardCategory_value = map[string]int32{
+		"MONSTER": 0,
+		"SPELL":   1,
+		"TRAP":    2,
+		"TOKEN":   3,
+	}
+)
+
+func (x CardCategory) Enum() *CardCategory {
+	p := new(CardCategory)
+	*p = x
+	return p
+}
+
+func (x CardCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[6].Descriptor()
+}
+
+func (CardCategory) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[6]
+}
+
+func (x CardCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardCategory.Descriptor instead.
+func (CardCategory) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
+type CardFrame int32
+
+const (
+	CardFrame_NORMAL_FRAME  CardFrame = 0
+	CardFrame_EFFECT_FRAME  CardFrame = 1
+	CardFrame_RITUAL_FRAME  CardFrame = 2
+	CardFrame_FUSION_FRAME  CardFrame = 3
+	CardFrame_SYNCHRO_FRAME CardFrame = 4
+	CardFrame_XYZ_FRAME     CardFrame = 5
+	CardFrame_LINK_FRAME    CardFrame = 6
+	CardFrame_SPELL_FRAME   CardFrame = 7
+	CardFrame_TRAP_FRAME    CardFrame = 8
+	CardFrame_TOKEN_FRAME   CardFrame = 9
+)
+
+// Enum value maps for CardFrame.
+var (
+	CardFrame_name = map[int32]string{
+		0: "NORMAL_FRAME",
+		1: "EFFECT_FRAME",
+		2: "RITUAL_FRAME",
+		3: "FUSION_FRAME",
+		4: "SYNCHRO_FRAME",
+		5: "XYZ_FRAME",
+		6: "LINK_FRAME",
+		7: "SPELL_FRAME",
+		8: "TRAP_FRAME",
+		9: "TOKEN_FRAME",
+	}
+	CardFrame_value = map[string]int32{
+		"NORMAL_FRAME":  0,
+		"EFFECT_FRAME":  1,
+		"RITUAL_FRAME":  2,
+		"FUSION_FRAME":  3,
+		"SYNCHRO_FRAME": 4,
+		"XYZ_FRAME":     5,
+		"LINK_FRAME":    6,
+		"SPELL_FRAME":   7,
+		"TRAP_FRAME":    8,
+		"TOKEN_FRAME":   9,
+	}
+)
+
+func (x CardFrame) Enum() *CardFrame {
+	p := new(CardFrame)
+	*p = x
+	return p
+}
+
+func (x CardFrame) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardFrame) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[7].Descriptor()
+}
+
+func (CardFrame) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[7]
+}
+
+func (x CardFrame) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardFrame.Descriptor instead.
+func (CardFrame) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7}
+}
+
+type EffectTag int32
+
+const (
+	EffectTag_NEGATE         EffectTag = 0
+	EffectTag_DESTROY        EffectTag = 1
+	EffectTag_BANISH         EffectTag = 2
+	EffectTag_DRAW           EffectTag = 3
+	EffectTag_SEARCH         EffectTag = 4
+	EffectTag_SPECIAL_SUMMON EffectTag = 5
+	EffectTag_SEND_TO_GY     EffectTag = 6
+	EffectTag_ONCE_PER_TURN  EffectTag = 7
+	EffectTag_QUICK_EFFECT   EffectTag = 8
+	EffectTag_INFLICT_DAMAGE EffectTag = 9
+	EffectTag_GAIN_LP        EffectTag = 10
+)
+
+// Enum value maps for EffectTag.
+var (
+	EffectTag_name = map[int32]string{
+		0:  "NEGATE",
+		1:  "DESTROY",
+		2:  "BANISH",
+		3:  "DRAW",
+		4:  "SEARCH",
+		5:  "SPECIAL_SUMMON",
+		6:  "SEND_TO_GY",
+		7:  "ONCE_PER_TURN",
+		8:  "QUICK_EFFECT",
+		9:  "INFLICT_DAMAGE",
+		10: "GAIN_LP",
+	}
+	EffectTag_value = map[string]int32{
+		"NEGATE":         0,
+		"DESTROY":        1,
+		"BANISH":         2,
+		"DRAW":           3,
+		"SEARCH":         4,
+		"SPECIAL_SUMMON": 5,
+		"SEND_TO_GY":     6,
+		"ONCE_PER_TURN":  7,
+		"QUICK_EFFECT":   8,
+		"INFLICT_DAMAGE": 9,
+		"GAIN_LP":        10,
+	}
+)
+
+func (x EffectTag) Enum() *EffectTag {
+	p := new(EffectTag)
+	*p = x
+	return p
+}
+
+func (x EffectTag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EffectTag) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[8].Descriptor()
+}
+
+func (EffectTag) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[8]
+}
+
+func (x EffectTag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EffectTag.Descriptor instead.
+func (EffectTag) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{8}
+}
+
+type OncePerTurn int32
+
+const (
+	OncePerTurn_NOT_ONCE_PER_TURN  OncePerTurn = 0
+	OncePerTurn_SOFT_ONCE_PER_TURN OncePerTurn = 1
+	OncePerTurn_HARD_ONCE_PER_TURN OncePerTurn = 2
+)
+
+// Enum value maps for OncePerTurn.
+var (
+	OncePerTurn_name = map[int32]string{
+		0: "NOT_ONCE_PER_TURN",
+		1: "SOFT_ONCE_PER_TURN",
+		2: "HARD_ONCE_PER_TURN",
+	}
+	OncePerTurn_value = map[string]int32{
+		"NOT_ONCE_PER_TURN":  0,
+		"SOFT_ONCE_PER_TURN": 1,
+		"HARD_ONCE_PER_TURN": 2,
+	}
+)
+
+func (x OncePerTurn) Enum() *OncePerTurn {
+	p := new(OncePerTurn)
+	*p = x
+	return p
+}
+
+func (x OncePerTurn) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OncePerTurn) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[9].Descriptor()
+}
+
+func (OncePerTurn) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[9]
+}
+
+func (x OncePerTurn) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OncePerTurn.Descriptor instead.
+func (OncePerTurn) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
+}
+
+// newest products first by default
+type ProductSortOrder int32
+
+const (
+	ProductSortOrder_RELEASE_DATE_DESC ProductSortOrder = 0
+	ProductSortOrder_RELEASE_DATE_ASC  ProductSortOrder = 1
+)
+
+// Enum value maps for ProductSortOrder.
+var (
+	ProductSortOrder_name = map[int32]string{
+		0: "RELEASE_DATE_DESC",
+		1: "RELEASE_DATE_ASC",
+	}
+	ProductSortOrder_value = map[string]int32{
+		"RELEASE_DATE_DESC": 0,
+		"RELEASE_DATE_ASC":  1,
+	}
+)
+
+func (x ProductSortOrder) Enum() *ProductSortOrder {
+	p := new(ProductSortOrder)
+	*p = x
+	return p
+}
+
+func (x ProductSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[10].Descriptor()
+}
+
+func (ProductSortOrder) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[10]
+}
+
+func (x ProductSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSortOrder.Descriptor instead.
+func (ProductSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
+}
+
 type ResourceID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -200,10 +736,12 @@ func (x *ResourceName) GetValue() string {
 }
 
 type ResourceNames struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Names              []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	IncludeSuggestions bool                   `protobuf:"varint,2,opt,name=include_suggestions,json=includeSuggestions,proto3" json:"include_suggestions,omitempty"`
+	IncludeHighlights  bool                   `protobuf:"varint,3,opt,name=include_highlights,json=includeHighlights,proto3" json:"include_highlights,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ResourceNames) Reset() {
@@ -243,6 +781,20 @@ func (x *ResourceNames) GetNames() []string {
 	return nil
 }
 
+func (x *ResourceNames) GetIncludeSuggestions() bool {
+	if x != nil {
+		return x.IncludeSuggestions
+	}
+	return false
+}
+
+func (x *ResourceNames) GetIncludeHighlights() bool {
+	if x != nil {
+		return x.IncludeHighlights
+	}
+	return false
+}
+
 type SearchTerm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -339,9 +891,15 @@ func (x *Archetype) GetArchetype() string {
 	return ""
 }
 
+// used for random selection - only cards matching all filters are considered. Reusing a seed with the same filters returns the same cards.
 type BlackListed struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	BlackListedRefs []string               `protobuf:"bytes,1,rep,name=black_listed_refs,json=blackListedRefs,proto3" json:"black_listed_refs,omitempty"`
+	Colors          []string               `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`
+	Attributes      []string               `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	MonsterTypes    []string               `protobuf:"bytes,4,rep,name=monster_types,json=monsterTypes,proto3" json:"monster_types,omitempty"`
+	Count           uint32                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Seed            *uint64                `protobuf:"varint,6,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -383,6 +941,41 @@ func (x *BlackListed) GetBlackListedRefs() []string {
 	return nil
 }
 
+func (x *BlackListed) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *BlackListed) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BlackListed) GetMonsterTypes() []string {
+	if x != nil {
+		return x.MonsterTypes
+	}
+	return nil
+}
+
+func (x *BlackListed) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BlackListed) GetSeed() uint64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
 type EffectiveTimeline struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AllDates      []string               `protobuf:"bytes,1,rep,name=allDates,proto3" json:"allDates,omitempty"`
@@ -455,17 +1048,27 @@ const file_common_proto_rawDesc = "" +
 	"\vResourceIDs\x12\x10\n" +
 	"\x03IDs\x18\x01 \x03(\tR\x03IDs\"$\n" +
 	"\fResourceName\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"%\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\x85\x01\n" +
 	"\rResourceNames\x12\x14\n" +
-	"\x05names\x18\x01 \x03(\tR\x05names\"0\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12/\n" +
+	"\x13include_suggestions\x18\x02 \x01(\bR\x12includeSuggestions\x12-\n" +
+	"\x12include_highlights\x18\x03 \x01(\bR\x11includeHighlights\"0\n" +
 	"\n" +
 	"SearchTerm\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\")\n" +
 	"\tArchetype\x12\x1c\n" +
-	"\tarchetype\x18\x01 \x01(\tR\tarchetype\"9\n" +
+	"\tarchetype\x18\x01 \x01(\tR\tarchetype\"\xce\x01\n" +
 	"\vBlackListed\x12*\n" +
-	"\x11black_listed_refs\x18\x01 \x03(\tR\x0fblackListedRefs\"q\n" +
+	"\x11black_listed_refs\x18\x01 \x03(\tR\x0fblackListedRefs\x12\x16\n" +
+	"\x06colors\x18\x02 \x03(\tR\x06colors\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\tR\n" +
+	"attributes\x12#\n" +
+	"\rmonster_types\x18\x04 \x03(\tR\fmonsterTypes\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\rR\x05count\x12\x17\n" +
+	"\x04seed\x18\x06 \x01(\x04H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"q\n" +
 	"\x11EffectiveTimeline\x12\x1a\n" +
 	"\ballDates\x18\x01 \x03(\tR\ballDates\x12 \n" +
 	"\vfutureDates\x18\x02 \x03(\tR\vfutureDates\x12\x1e\n" +
@@ -474,7 +1077,71 @@ const file_common_proto_rawDesc = "" +
 	"activeDate*i\n" +
 	"\x18CardRestrictionSortOrder\x12 \n" +
 	"\x1cCARD_COLOR_ASC_CARD_NAME_ASC\x10\x00\x12+\n" +
-	"'SCORE_DESC_CARD_COLOR_ASC_CARD_NAME_ASC\x10\x01B\x06Z\x04/ygob\x06proto3"
+	"'SCORE_DESC_CARD_COLOR_ASC_CARD_NAME_ASC\x10\x01*j\n" +
+	"\rCardSortOrder\x12\f\n" +
+	"\bNAME_ASC\x10\x00\x12\x16\n" +
+	"\x12COLOR_ASC_NAME_ASC\x10\x01\x12\x18\n" +
+	"\x14ATTACK_DESC_NAME_ASC\x10\x02\x12\x19\n" +
+	"\x15DEFENSE_DESC_NAME_ASC\x10\x03*P\n" +
+	"\x10TunerRequirement\x12\x10\n" +
+	"\fMATERIAL_ANY\x10\x00\x12\x12\n" +
+	"\x0eMATERIAL_TUNER\x10\x01\x12\x16\n" +
+	"\x12MATERIAL_NON_TUNER\x10\x02*B\n" +
+	"\x11CardReferenceType\x12\f\n" +
+	"\bMENTIONS\x10\x00\x12\x10\n" +
+	"\fMENTIONED_BY\x10\x01\x12\r\n" +
+	"\tARCHETYPE\x10\x02*A\n" +
+	"\x18ArchetypeInclusionReason\x12\x0e\n" +
+	"\n" +
+	"NAME_MATCH\x10\x00\x12\x15\n" +
+	"\x11ALWAYS_TREATED_AS\x10\x01*:\n" +
+	"\fDeckLocation\x12\r\n" +
+	"\tMAIN_DECK\x10\x00\x12\x0e\n" +
+	"\n" +
+	"EXTRA_DECK\x10\x01\x12\v\n" +
+	"\aNO_DECK\x10\x02*;\n" +
+	"\fCardCategory\x12\v\n" +
+	"\aMONSTER\x10\x00\x12\t\n" +
+	"\x05SPELL\x10\x01\x12\b\n" +
+	"\x04TRAP\x10\x02\x12\t\n" +
+	"\x05TOKEN\x10\x03*\xb7\x01\n" +
+	"\tCardFrame\x12\x10\n" +
+	"\fNORMAL_FRAME\x10\x00\x12\x10\n" +
+	"\fEFFECT_FRAME\x10\x01\x12\x10\n" +
+	"\fRITUAL_FRAME\x10\x02\x12\x10\n" +
+	"\fFUSION_FRAME\x10\x03\x12\x11\n" +
+	"\rSYNCHRO_FRAME\x10\x04\x12\r\n" +
+	"\tXYZ_FRAME\x10\x05\x12\x0e\n" +
+	"\n" +
+	"LINK_FRAME\x10\x06\x12\x0f\n" +
+	"\vSPELL_FRAME\x10\a\x12\x0e\n" +
+	"\n" +
+	"TRAP_FRAME\x10\b\x12\x0f\n" +
+	"\vTOKEN_FRAME\x10\t*\xb0\x01\n" +
+	"\tEffectTag\x12\n" +
+	"\n" +
+	"\x06NEGATE\x10\x00\x12\v\n" +
+	"\aDESTROY\x10\x01\x12\n" +
+	"\n" +
+	"\x06BANISH\x10\x02\x12\b\n" +
+	"\x04DRAW\x10\x03\x12\n" +
+	"\n" +
+	"\x06SEARCH\x10\x04\x12\x12\n" +
+	"\x0eSPECIAL_SUMMON\x10\x05\x12\x0e\n" +
+	"\n" +
+	"SEND_TO_GY\x10\x06\x12\x11\n" +
+	"\rONCE_PER_TURN\x10\a\x12\x10\n" +
+	"\fQUICK_EFFECT\x10\b\x12\x12\n" +
+	"\x0eINFLICT_DAMAGE\x10\t\x12\v\n" +
+	"\aGAIN_LP\x10\n" +
+	"*T\n" +
+	"\vOncePerTurn\x12\x15\n" +
+	"\x11NOT_ONCE_PER_TURN\x10\x00\x12\x16\n" +
+	"\x12SOFT_ONCE_PER_TURN\x10\x01\x12\x16\n" +
+	"\x12HARD_ONCE_PER_TURN\x10\x02*?\n" +
+	"\x10ProductSortOrder\x12\x15\n" +
+	"\x11RELEASE_DATE_DESC\x10\x00\x12\x14\n" +
+	"\x10RELEASE_DATE_ASC\x10\x01B\x06Z\x04/ygob\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -488,18 +1155,28 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
 var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_common_proto_goTypes = []any{
 	(CardRestrictionSortOrder)(0), // 0: ygo.common.CardRestrictionSortOrder
-	(*ResourceID)(nil),            // 1: ygo.common.ResourceID
-	(*ResourceIDs)(nil),           // 2: ygo.common.ResourceIDs
-	(*ResourceName)(nil),          // 3: ygo.common.ResourceName
-	(*ResourceNames)(nil),         // 4: ygo.common.ResourceNames
-	(*SearchTerm)(nil),            // 5: ygo.common.SearchTerm
-	(*Archetype)(nil),             // 6: ygo.common.Archetype
-	(*BlackListed)(nil),           // 7: ygo.common.BlackListed
-	(*EffectiveTimeline)(nil),     // 8: ygo.common.EffectiveTimeline
+	(CardSortOrder)(0),            // 1: ygo.common.CardSortOrder
+	(TunerRequirement)(0),         // 2: ygo.common.TunerRequirement
+	(CardReferenceType)(0),        // 3: ygo.common.CardReferenceType
+	(ArchetypeInclusionReason)(0), // 4: ygo.common.ArchetypeInclusionReason
+	(DeckLocation)(0),             // 5: ygo.common.DeckLocation
+	(CardCategory)(0),             // 6: ygo.common.CardCategory
+	(CardFrame)(0),                // 7: ygo.common.CardFrame
+	(EffectTag)(0),                // 8: ygo.common.EffectTag
+	(OncePerTurn)(0),              // 9: ygo.common.OncePerTurn
+	(ProductSortOrder)(0),         // 10: ygo.common.ProductSortOrder
+	(*ResourceID)(nil),            // 11: ygo.common.ResourceID
+	(*ResourceIDs)(nil),           // 12: ygo.common.ResourceIDs
+	(*ResourceName)(nil),          // 13: ygo.common.ResourceName
+	(*ResourceNames)(nil),         // 14: ygo.common.ResourceNames
+	(*SearchTerm)(nil),            // 15: ygo.common.SearchTerm
+	(*Archetype)(nil),             // 16: ygo.common.Archetype
+	(*BlackListed)(nil),           // 17: ygo.common.BlackListed
+	(*EffectiveTimeline)(nil),     // 18: ygo.common.EffectiveTimeline
 }
 var file_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -514,12 +1191,13 @@ func file_common_proto_init() {
 	if File_common_proto != nil {
 		return
 	}
+	file_common_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      11,
 			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
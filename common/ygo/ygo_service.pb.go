@@ -68,17 +68,24 @@ func (x *CardColors) GetValues() map[string]uint32 {
 }
 
 type Card struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ID            string                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Color         string                  `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
-	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Attribute     string                  `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
-	Effect        string                  `protobuf:"bytes,5,opt,name=effect,proto3" json:"effect,omitempty"`
-	MonsterType   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=monster_type,json=monsterType,proto3" json:"monster_type,omitempty"`
-	Attack        *wrapperspb.UInt32Value `protobuf:"bytes,7,opt,name=attack,proto3" json:"attack,omitempty"`
-	Defense       *wrapperspb.UInt32Value `protobuf:"bytes,8,opt,name=defense,proto3" json:"defense,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	ID                 string                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Color              string                  `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Name               string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Attribute          string                  `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Effect             string                  `protobuf:"bytes,5,opt,name=effect,proto3" json:"effect,omitempty"`
+	MonsterType        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=monster_type,json=monsterType,proto3" json:"monster_type,omitempty"`
+	Attack             *wrapperspb.UInt32Value `protobuf:"bytes,7,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defense            *wrapperspb.UInt32Value `protobuf:"bytes,8,opt,name=defense,proto3" json:"defense,omitempty"`
+	Materials          *Materials              `protobuf:"bytes,9,opt,name=materials,proto3" json:"materials,omitempty"`
+	PendulumScale      *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=pendulum_scale,json=pendulumScale,proto3" json:"pendulum_scale,omitempty"`
+	PendulumEffect     *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=pendulum_effect,json=pendulumEffect,proto3" json:"pendulum_effect,omitempty"`
+	MonsterEffect      *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=monster_effect,json=monsterEffect,proto3" json:"monster_effect,omitempty"`
+	MonsterTypeDetails *MonsterType            `protobuf:"bytes,13,opt,name=monster_type_details,json=monsterTypeDetails,proto3" json:"monster_type_details,omitempty"`
+	Classification     *CardClassification     `protobuf:"bytes,14,opt,name=classification,proto3" json:"classification,omitempty"`
+	Tags               []*EffectTagMatch       `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Card) Reset() {
@@ -167,28 +174,78 @@ func (x *Card) GetDefense() *wrapperspb.UInt32Value {
 	return nil
 }
 
-type Cards struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	CardInfo         map[string]*Card       `protobuf:"bytes,1,rep,name=card_info,json=cardInfo,proto3" json:"card_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UnknownResources []string               `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Card) GetMaterials() *Materials {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
 }
 
-func (x *Cards) Reset() {
-	*x = Cards{}
+func (x *Card) GetPendulumScale() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PendulumScale
+	}
+	return nil
+}
+
+func (x *Card) GetPendulumEffect() *wrapperspb.StringValue {
+	if x != nil {
+		return x.PendulumEffect
+	}
+	return nil
+}
+
+func (x *Card) GetMonsterEffect() *wrapperspb.StringValue {
+	if x != nil {
+		return x.MonsterEffect
+	}
+	return nil
+}
+
+func (x *Card) GetMonsterTypeDetails() *MonsterType {
+	if x != nil {
+		return x.MonsterTypeDetails
+	}
+	return nil
+}
+
+func (x *Card) GetClassification() *CardClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+func (x *Card) GetTags() []*EffectTagMatch {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// limit defaults to 10 and cannot exceed 50
+type SimilarCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarCardsRequest) Reset() {
+	*x = SimilarCardsRequest{}
 	mi := &file_ygo_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Cards) String() string {
+func (x *SimilarCardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cards) ProtoMessage() {}
+func (*SimilarCardsRequest) ProtoMessage() {}
 
-func (x *Cards) ProtoReflect() protoreflect.Message {
+func (x *SimilarCardsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ygo_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,46 +257,48 @@ func (x *Cards) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cards.ProtoReflect.Descriptor instead.
-func (*Cards) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimilarCardsRequest.ProtoReflect.Descriptor instead.
+func (*SimilarCardsRequest) Descriptor() ([]byte, []int) {
 	return file_ygo_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Cards) GetCardInfo() map[string]*Card {
+func (x *SimilarCardsRequest) GetID() string {
 	if x != nil {
-		return x.CardInfo
+		return x.ID
 	}
-	return nil
+	return ""
 }
 
-func (x *Cards) GetUnknownResources() []string {
+func (x *SimilarCardsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.UnknownResources
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type CardList struct {
+// scores are the cosine similarity of the effect, color, attribute and monster type of both cards
+type SimilarCards struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Similar       []*SimilarCard         `protobuf:"bytes,2,rep,name=similar,proto3" json:"similar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardList) Reset() {
-	*x = CardList{}
+func (x *SimilarCards) Reset() {
+	*x = SimilarCards{}
 	mi := &file_ygo_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardList) String() string {
+func (x *SimilarCards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardList) ProtoMessage() {}
+func (*SimilarCards) ProtoMessage() {}
 
-func (x *CardList) ProtoReflect() protoreflect.Message {
+func (x *SimilarCards) ProtoReflect() protoreflect.Message {
 	mi := &file_ygo_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,47 +310,47 @@ func (x *CardList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardList.ProtoReflect.Descriptor instead.
-func (*CardList) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimilarCards.ProtoReflect.Descriptor instead.
+func (*SimilarCards) Descriptor() ([]byte, []int) {
 	return file_ygo_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CardList) GetCards() []*Card {
+func (x *SimilarCards) GetCard() *Card {
 	if x != nil {
-		return x.Cards
+		return x.Card
 	}
 	return nil
 }
 
-type Product struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ID                 string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Locale             string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
-	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	SubType            string                 `protobuf:"bytes,5,opt,name=subType,proto3" json:"subType,omitempty"`
-	ReleaseDate        string                 `protobuf:"bytes,6,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	TotalItems         uint32                 `protobuf:"varint,7,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
-	Items              []*ProductItem         `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
-	RarityDistribution map[string]uint32      `protobuf:"bytes,9,rep,name=rarityDistribution,proto3" json:"rarityDistribution,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *SimilarCards) GetSimilar() []*SimilarCard {
+	if x != nil {
+		return x.Similar
+	}
+	return nil
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
+type SimilarCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarCard) Reset() {
+	*x = SimilarCard{}
 	mi := &file_ygo_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *SimilarCard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*SimilarCard) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
+func (x *SimilarCard) ProtoReflect() protoreflect.Message {
 	mi := &file_ygo_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -303,98 +362,3258 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimilarCard.ProtoReflect.Descriptor instead.
+func (*SimilarCard) Descriptor() ([]byte, []int) {
 	return file_ygo_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Product) GetID() string {
+func (x *SimilarCard) GetCard() *Card {
 	if x != nil {
-		return x.ID
+		return x.Card
 	}
-	return ""
+	return nil
 }
 
-func (x *Product) GetLocale() string {
+func (x *SimilarCard) GetScore() float32 {
 	if x != nil {
-		return x.Locale
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetName() string {
+// effect excludes the material line of extra deck monsters and the Pendulum effect of Pendulum monsters. Normal monsters have no breakdown.
+type CardEffectBreakdown struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Card           *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Effect         *EffectBreakdown       `protobuf:"bytes,2,opt,name=effect,proto3" json:"effect,omitempty"`
+	PendulumEffect *EffectBreakdown       `protobuf:"bytes,3,opt,name=pendulum_effect,json=pendulumEffect,proto3" json:"pendulum_effect,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CardEffectBreakdown) Reset() {
+	*x = CardEffectBreakdown{}
+	mi := &file_ygo_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardEffectBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardEffectBreakdown) ProtoMessage() {}
+
+func (x *CardEffectBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[5]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardEffectBreakdown.ProtoReflect.Descriptor instead.
+func (*CardEffectBreakdown) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CardEffectBreakdown) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *CardEffectBreakdown) GetEffect() *EffectBreakdown {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+func (x *CardEffectBreakdown) GetPendulumEffect() *EffectBreakdown {
+	if x != nil {
+		return x.PendulumEffect
+	}
+	return nil
+}
+
+// restrictions are sentences such as: You can only use this effect of "X" once per turn.
+type EffectBreakdown struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Effects           []*EffectClause        `protobuf:"bytes,1,rep,name=effects,proto3" json:"effects,omitempty"`
+	Restrictions      []string               `protobuf:"bytes,2,rep,name=restrictions,proto3" json:"restrictions,omitempty"`
+	ActivationLimited bool                   `protobuf:"varint,3,opt,name=activation_limited,json=activationLimited,proto3" json:"activation_limited,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EffectBreakdown) Reset() {
+	*x = EffectBreakdown{}
+	mi := &file_ygo_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectBreakdown) ProtoMessage() {}
+
+func (x *EffectBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectBreakdown.ProtoReflect.Descriptor instead.
+func (*EffectBreakdown) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EffectBreakdown) GetEffects() []*EffectClause {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *EffectBreakdown) GetRestrictions() []string {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+func (x *EffectBreakdown) GetActivationLimited() bool {
+	if x != nil {
+		return x.ActivationLimited
+	}
+	return false
+}
+
+// condition is the text before ":" and cost is the text before ";"
+type EffectClause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Condition     string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Cost          string                 `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Resolution    string                 `protobuf:"bytes,5,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	OncePerTurn   OncePerTurn            `protobuf:"varint,6,opt,name=once_per_turn,json=oncePerTurn,proto3,enum=ygo.common.OncePerTurn" json:"once_per_turn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectClause) Reset() {
+	*x = EffectClause{}
+	mi := &file_ygo_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectClause) ProtoMessage() {}
+
+func (x *EffectClause) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectClause.ProtoReflect.Descriptor instead.
+func (*EffectClause) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EffectClause) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *EffectClause) GetText() string {
+	if x != nil {
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Product) GetType() string {
+func (x *EffectClause) GetCondition() string {
 	if x != nil {
-		return x.Type
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *Product) GetSubType() string {
+func (x *EffectClause) GetCost() string {
 	if x != nil {
-		return x.SubType
+		return x.Cost
 	}
 	return ""
 }
 
-func (x *Product) GetReleaseDate() string {
+func (x *EffectClause) GetResolution() string {
 	if x != nil {
-		return x.ReleaseDate
+		return x.Resolution
 	}
 	return ""
 }
 
-func (x *Product) GetTotalItems() uint32 {
+func (x *EffectClause) GetOncePerTurn() OncePerTurn {
 	if x != nil {
-		return x.TotalItems
+		return x.OncePerTurn
 	}
-	return 0
+	return OncePerTurn_NOT_ONCE_PER_TURN
+}
+
+// spans point to the text of the effect that triggered the tag
+type EffectTagMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           EffectTag              `protobuf:"varint,1,opt,name=tag,proto3,enum=ygo.common.EffectTag" json:"tag,omitempty"`
+	Spans         []*TextSpan            `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectTagMatch) Reset() {
+	*x = EffectTagMatch{}
+	mi := &file_ygo_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectTagMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectTagMatch) ProtoMessage() {}
+
+func (x *EffectTagMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectTagMatch.ProtoReflect.Descriptor instead.
+func (*EffectTagMatch) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EffectTagMatch) GetTag() EffectTag {
+	if x != nil {
+		return x.Tag
+	}
+	return EffectTag_NEGATE
+}
+
+func (x *EffectTagMatch) GetSpans() []*TextSpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+// derived from the card_colors name of a card. Pendulum monsters use the frame of their bottom half.
+type CardClassification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frame         CardFrame              `protobuf:"varint,1,opt,name=frame,proto3,enum=ygo.common.CardFrame" json:"frame,omitempty"`
+	Pendulum      bool                   `protobuf:"varint,2,opt,name=pendulum,proto3" json:"pendulum,omitempty"`
+	DeckLocation  DeckLocation           `protobuf:"varint,3,opt,name=deck_location,json=deckLocation,proto3,enum=ygo.common.DeckLocation" json:"deck_location,omitempty"`
+	Category      CardCategory           `protobuf:"varint,4,opt,name=category,proto3,enum=ygo.common.CardCategory" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardClassification) Reset() {
+	*x = CardClassification{}
+	mi := &file_ygo_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardClassification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardClassification) ProtoMessage() {}
+
+func (x *CardClassification) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardClassification.ProtoReflect.Descriptor instead.
+func (*CardClassification) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CardClassification) GetFrame() CardFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return CardFrame_NORMAL_FRAME
+}
+
+func (x *CardClassification) GetPendulum() bool {
+	if x != nil {
+		return x.Pendulum
+	}
+	return false
+}
+
+func (x *CardClassification) GetDeckLocation() DeckLocation {
+	if x != nil {
+		return x.DeckLocation
+	}
+	return DeckLocation_MAIN_DECK
+}
+
+func (x *CardClassification) GetCategory() CardCategory {
+	if x != nil {
+		return x.Category
+	}
+	return CardCategory_MONSTER
+}
+
+// monster_type of a card split into its type (Spellcaster, Dragon, etc), abilities and summon frames
+type MonsterType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Tuner         bool                   `protobuf:"varint,2,opt,name=tuner,proto3" json:"tuner,omitempty"`
+	Flip          bool                   `protobuf:"varint,3,opt,name=flip,proto3" json:"flip,omitempty"`
+	Gemini        bool                   `protobuf:"varint,4,opt,name=gemini,proto3" json:"gemini,omitempty"`
+	Spirit        bool                   `protobuf:"varint,5,opt,name=spirit,proto3" json:"spirit,omitempty"`
+	Union         bool                   `protobuf:"varint,6,opt,name=union,proto3" json:"union,omitempty"`
+	Toon          bool                   `protobuf:"varint,7,opt,name=toon,proto3" json:"toon,omitempty"`
+	Normal        bool                   `protobuf:"varint,8,opt,name=normal,proto3" json:"normal,omitempty"`
+	Effect        bool                   `protobuf:"varint,9,opt,name=effect,proto3" json:"effect,omitempty"`
+	Ritual        bool                   `protobuf:"varint,10,opt,name=ritual,proto3" json:"ritual,omitempty"`
+	Fusion        bool                   `protobuf:"varint,11,opt,name=fusion,proto3" json:"fusion,omitempty"`
+	Synchro       bool                   `protobuf:"varint,12,opt,name=synchro,proto3" json:"synchro,omitempty"`
+	Xyz           bool                   `protobuf:"varint,13,opt,name=xyz,proto3" json:"xyz,omitempty"`
+	Pendulum      bool                   `protobuf:"varint,14,opt,name=pendulum,proto3" json:"pendulum,omitempty"`
+	Link          bool                   `protobuf:"varint,15,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonsterType) Reset() {
+	*x = MonsterType{}
+	mi := &file_ygo_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonsterType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonsterType) ProtoMessage() {}
+
+func (x *MonsterType) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonsterType.ProtoReflect.Descriptor instead.
+func (*MonsterType) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MonsterType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MonsterType) GetTuner() bool {
+	if x != nil {
+		return x.Tuner
+	}
+	return false
+}
+
+func (x *MonsterType) GetFlip() bool {
+	if x != nil {
+		return x.Flip
+	}
+	return false
+}
+
+func (x *MonsterType) GetGemini() bool {
+	if x != nil {
+		return x.Gemini
+	}
+	return false
+}
+
+func (x *MonsterType) GetSpirit() bool {
+	if x != nil {
+		return x.Spirit
+	}
+	return false
+}
+
+func (x *MonsterType) GetUnion() bool {
+	if x != nil {
+		return x.Union
+	}
+	return false
+}
+
+func (x *MonsterType) GetToon() bool {
+	if x != nil {
+		return x.Toon
+	}
+	return false
+}
+
+func (x *MonsterType) GetNormal() bool {
+	if x != nil {
+		return x.Normal
+	}
+	return false
+}
+
+func (x *MonsterType) GetEffect() bool {
+	if x != nil {
+		return x.Effect
+	}
+	return false
+}
+
+func (x *MonsterType) GetRitual() bool {
+	if x != nil {
+		return x.Ritual
+	}
+	return false
+}
+
+func (x *MonsterType) GetFusion() bool {
+	if x != nil {
+		return x.Fusion
+	}
+	return false
+}
+
+func (x *MonsterType) GetSynchro() bool {
+	if x != nil {
+		return x.Synchro
+	}
+	return false
+}
+
+func (x *MonsterType) GetXyz() bool {
+	if x != nil {
+		return x.Xyz
+	}
+	return false
+}
+
+func (x *MonsterType) GetPendulum() bool {
+	if x != nil {
+		return x.Pendulum
+	}
+	return false
+}
+
+func (x *MonsterType) GetLink() bool {
+	if x != nil {
+		return x.Link
+	}
+	return false
+}
+
+// only populated for Fusion, Synchro, Xyz and Link monsters
+type Materials struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Text          string                  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	MinCount      uint32                  `protobuf:"varint,2,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	MaxCount      *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"` // null when any number of materials above min_count can be used
+	Requirements  []*MaterialRequirement  `protobuf:"bytes,4,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Materials) Reset() {
+	*x = Materials{}
+	mi := &file_ygo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Materials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Materials) ProtoMessage() {}
+
+func (x *Materials) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Materials.ProtoReflect.Descriptor instead.
+func (*Materials) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Materials) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Materials) GetMinCount() uint32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Materials) GetMaxCount() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxCount
+	}
+	return nil
+}
+
+func (x *Materials) GetRequirements() []*MaterialRequirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+type MaterialRequirement struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Text          string                  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Count         uint32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	OrMore        bool                    `protobuf:"varint,3,opt,name=or_more,json=orMore,proto3" json:"or_more,omitempty"`
+	Names         []string                `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	Archetype     string                  `protobuf:"bytes,5,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	Attributes    []string                `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Types         []string                `protobuf:"bytes,7,rep,name=types,proto3" json:"types,omitempty"`
+	Frames        []string                `protobuf:"bytes,8,rep,name=frames,proto3" json:"frames,omitempty"`
+	Tuner         TunerRequirement        `protobuf:"varint,9,opt,name=tuner,proto3,enum=ygo.common.TunerRequirement" json:"tuner,omitempty"`
+	MinLevel      *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel      *wrapperspb.UInt32Value `protobuf:"bytes,11,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Inclusion     bool                    `protobuf:"varint,12,opt,name=inclusion,proto3" json:"inclusion,omitempty"` // satisfied by one of the materials counted by previous requirements
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialRequirement) Reset() {
+	*x = MaterialRequirement{}
+	mi := &file_ygo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialRequirement) ProtoMessage() {}
+
+func (x *MaterialRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialRequirement.ProtoReflect.Descriptor instead.
+func (*MaterialRequirement) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MaterialRequirement) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MaterialRequirement) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MaterialRequirement) GetOrMore() bool {
+	if x != nil {
+		return x.OrMore
+	}
+	return false
+}
+
+func (x *MaterialRequirement) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetArchetype() string {
+	if x != nil {
+		return x.Archetype
+	}
+	return ""
+}
+
+func (x *MaterialRequirement) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetFrames() []string {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetTuner() TunerRequirement {
+	if x != nil {
+		return x.Tuner
+	}
+	return TunerRequirement_MATERIAL_ANY
+}
+
+func (x *MaterialRequirement) GetMinLevel() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MinLevel
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetMaxLevel() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return nil
+}
+
+func (x *MaterialRequirement) GetInclusion() bool {
+	if x != nil {
+		return x.Inclusion
+	}
+	return false
+}
+
+type MaterialUsages struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Material           *Card                  `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	NamedExplicitly    []*Card                `protobuf:"bytes,2,rep,name=named_explicitly,json=namedExplicitly,proto3" json:"named_explicitly,omitempty"`
+	MatchesGenerically []*Card                `protobuf:"bytes,3,rep,name=matches_generically,json=matchesGenerically,proto3" json:"matches_generically,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MaterialUsages) Reset() {
+	*x = MaterialUsages{}
+	mi := &file_ygo_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialUsages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialUsages) ProtoMessage() {}
+
+func (x *MaterialUsages) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialUsages.ProtoReflect.Descriptor instead.
+func (*MaterialUsages) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MaterialUsages) GetMaterial() *Card {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *MaterialUsages) GetNamedExplicitly() []*Card {
+	if x != nil {
+		return x.NamedExplicitly
+	}
+	return nil
+}
+
+func (x *MaterialUsages) GetMatchesGenerically() []*Card {
+	if x != nil {
+		return x.MatchesGenerically
+	}
+	return nil
+}
+
+type Cards struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	CardInfo         map[string]*Card                `protobuf:"bytes,1,rep,name=card_info,json=cardInfo,proto3" json:"card_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UnknownResources []string                        `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
+	Suggestions      map[string]*CardNameSuggestions `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by unknown resource, only populated when suggestions are requested
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Cards) Reset() {
+	*x = Cards{}
+	mi := &file_ygo_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cards) ProtoMessage() {}
+
+func (x *Cards) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cards.ProtoReflect.Descriptor instead.
+func (*Cards) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Cards) GetCardInfo() map[string]*Card {
+	if x != nil {
+		return x.CardInfo
+	}
+	return nil
+}
+
+func (x *Cards) GetUnknownResources() []string {
+	if x != nil {
+		return x.UnknownResources
+	}
+	return nil
+}
+
+func (x *Cards) GetSuggestions() map[string]*CardNameSuggestions {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// highlights are keyed by card ID and only populated when requested
+type CardList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Highlights    map[string]*TextSpans  `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardList) Reset() {
+	*x = CardList{}
+	mi := &file_ygo_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardList) ProtoMessage() {}
+
+func (x *CardList) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardList.ProtoReflect.Descriptor instead.
+func (*CardList) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CardList) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardList) GetHighlights() map[string]*TextSpans {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// rune offsets of a match in a card effect, end is exclusive
+type TextSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         uint32                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           uint32                 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextSpan) Reset() {
+	*x = TextSpan{}
+	mi := &file_ygo_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextSpan) ProtoMessage() {}
+
+func (x *TextSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextSpan.ProtoReflect.Descriptor instead.
+func (*TextSpan) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TextSpan) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextSpan) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type TextSpans struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spans         []*TextSpan            `protobuf:"bytes,1,rep,name=spans,proto3" json:"spans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextSpans) Reset() {
+	*x = TextSpans{}
+	mi := &file_ygo_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextSpans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextSpans) ProtoMessage() {}
+
+func (x *TextSpans) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextSpans.ProtoReflect.Descriptor instead.
+func (*TextSpans) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TextSpans) GetSpans() []*TextSpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+// seed can be sent back to retrieve the same cards again
+type RandomCards struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Seed          uint64                 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomCards) Reset() {
+	*x = RandomCards{}
+	mi := &file_ygo_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomCards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomCards) ProtoMessage() {}
+
+func (x *RandomCards) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomCards.ProtoReflect.Descriptor instead.
+func (*RandomCards) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RandomCards) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *RandomCards) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// date uses YYYY-MM-DD format, current date in Chicago is used when empty
+type CardOfTheDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardOfTheDayRequest) Reset() {
+	*x = CardOfTheDayRequest{}
+	mi := &file_ygo_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardOfTheDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardOfTheDayRequest) ProtoMessage() {}
+
+func (x *CardOfTheDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardOfTheDayRequest.ProtoReflect.Descriptor instead.
+func (*CardOfTheDayRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CardOfTheDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CardOfTheDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Card          *Card                  `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardOfTheDay) Reset() {
+	*x = CardOfTheDay{}
+	mi := &file_ygo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardOfTheDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardOfTheDay) ProtoMessage() {}
+
+func (x *CardOfTheDay) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardOfTheDay.ProtoReflect.Descriptor instead.
+func (*CardOfTheDay) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CardOfTheDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CardOfTheDay) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type CardSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *CardSearchFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortOrder     CardSortOrder          `protobuf:"varint,2,opt,name=sort_order,json=sortOrder,proto3,enum=ygo.common.CardSortOrder" json:"sort_order,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardSearchRequest) Reset() {
+	*x = CardSearchRequest{}
+	mi := &file_ygo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSearchRequest) ProtoMessage() {}
+
+func (x *CardSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSearchRequest.ProtoReflect.Descriptor instead.
+func (*CardSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CardSearchRequest) GetFilter() *CardSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CardSearchRequest) GetSortOrder() CardSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return CardSortOrder_NAME_ASC
+}
+
+func (x *CardSearchRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CardSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type CardSearchFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Colors        []string               `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty"`
+	Attributes    []string               `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	MonsterTypes  []string               `protobuf:"bytes,3,rep,name=monster_types,json=monsterTypes,proto3" json:"monster_types,omitempty"`
+	Attack        *StatRange             `protobuf:"bytes,4,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defense       *StatRange             `protobuf:"bytes,5,opt,name=defense,proto3" json:"defense,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	MonsterType   *MonsterTypeFilter     `protobuf:"bytes,7,opt,name=monster_type,json=monsterType,proto3" json:"monster_type,omitempty"`
+	Tags          []EffectTag            `protobuf:"varint,8,rep,packed,name=tags,proto3,enum=ygo.common.EffectTag" json:"tags,omitempty"` // cards need every tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardSearchFilter) Reset() {
+	*x = CardSearchFilter{}
+	mi := &file_ygo_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSearchFilter) ProtoMessage() {}
+
+func (x *CardSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSearchFilter.ProtoReflect.Descriptor instead.
+func (*CardSearchFilter) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CardSearchFilter) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetMonsterTypes() []string {
+	if x != nil {
+		return x.MonsterTypes
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetAttack() *StatRange {
+	if x != nil {
+		return x.Attack
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetDefense() *StatRange {
+	if x != nil {
+		return x.Defense
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CardSearchFilter) GetMonsterType() *MonsterTypeFilter {
+	if x != nil {
+		return x.MonsterType
+	}
+	return nil
+}
+
+func (x *CardSearchFilter) GetTags() []EffectTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// unset fields are ignored, set flags need to match the value of the flag. Filtering by any field excludes Spells and Traps.
+type MonsterTypeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Tuner         *wrapperspb.BoolValue  `protobuf:"bytes,2,opt,name=tuner,proto3" json:"tuner,omitempty"`
+	Flip          *wrapperspb.BoolValue  `protobuf:"bytes,3,opt,name=flip,proto3" json:"flip,omitempty"`
+	Gemini        *wrapperspb.BoolValue  `protobuf:"bytes,4,opt,name=gemini,proto3" json:"gemini,omitempty"`
+	Spirit        *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=spirit,proto3" json:"spirit,omitempty"`
+	Union         *wrapperspb.BoolValue  `protobuf:"bytes,6,opt,name=union,proto3" json:"union,omitempty"`
+	Toon          *wrapperspb.BoolValue  `protobuf:"bytes,7,opt,name=toon,proto3" json:"toon,omitempty"`
+	Normal        *wrapperspb.BoolValue  `protobuf:"bytes,8,opt,name=normal,proto3" json:"normal,omitempty"`
+	Effect        *wrapperspb.BoolValue  `protobuf:"bytes,9,opt,name=effect,proto3" json:"effect,omitempty"`
+	Ritual        *wrapperspb.BoolValue  `protobuf:"bytes,10,opt,name=ritual,proto3" json:"ritual,omitempty"`
+	Fusion        *wrapperspb.BoolValue  `protobuf:"bytes,11,opt,name=fusion,proto3" json:"fusion,omitempty"`
+	Synchro       *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=synchro,proto3" json:"synchro,omitempty"`
+	Xyz           *wrapperspb.BoolValue  `protobuf:"bytes,13,opt,name=xyz,proto3" json:"xyz,omitempty"`
+	Pendulum      *wrapperspb.BoolValue  `protobuf:"bytes,14,opt,name=pendulum,proto3" json:"pendulum,omitempty"`
+	Link          *wrapperspb.BoolValue  `protobuf:"bytes,15,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonsterTypeFilter) Reset() {
+	*x = MonsterTypeFilter{}
+	mi := &file_ygo_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonsterTypeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonsterTypeFilter) ProtoMessage() {}
+
+func (x *MonsterTypeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonsterTypeFilter.ProtoReflect.Descriptor instead.
+func (*MonsterTypeFilter) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MonsterTypeFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MonsterTypeFilter) GetTuner() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Tuner
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetFlip() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Flip
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetGemini() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Gemini
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetSpirit() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Spirit
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetUnion() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Union
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetToon() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Toon
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetNormal() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetEffect() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetRitual() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Ritual
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetFusion() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Fusion
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetSynchro() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Synchro
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetXyz() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Xyz
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetPendulum() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Pendulum
+	}
+	return nil
+}
+
+func (x *MonsterTypeFilter) GetLink() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// bounds are inclusive, monsters with "?" ATK/DEF are stored as null and only match if include_unknown is set
+type StatRange struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Min            *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max            *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	IncludeUnknown bool                    `protobuf:"varint,3,opt,name=include_unknown,json=includeUnknown,proto3" json:"include_unknown,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatRange) Reset() {
+	*x = StatRange{}
+	mi := &file_ygo_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatRange) ProtoMessage() {}
+
+func (x *StatRange) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatRange.ProtoReflect.Descriptor instead.
+func (*StatRange) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StatRange) GetMin() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *StatRange) GetMax() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *StatRange) GetIncludeUnknown() bool {
+	if x != nil {
+		return x.IncludeUnknown
+	}
+	return false
+}
+
+// searches using tags can return fewer cards than the page size (or none) along with a cursor, keep paging until the cursor is empty
+type CardSearchResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardSearchResults) Reset() {
+	*x = CardSearchResults{}
+	mi := &file_ygo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardSearchResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSearchResults) ProtoMessage() {}
+
+func (x *CardSearchResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSearchResults.ProtoReflect.Descriptor instead.
+func (*CardSearchResults) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CardSearchResults) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardSearchResults) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// query supports AND, OR, NOT, "phrases", prefix* wildcards and name: / effect: fields - ie: banish AND "face-down" NOT name:HERO
+type CardTextSearchRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Query             string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize          uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor            string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeHighlights bool                   `protobuf:"varint,4,opt,name=include_highlights,json=includeHighlights,proto3" json:"include_highlights,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CardTextSearchRequest) Reset() {
+	*x = CardTextSearchRequest{}
+	mi := &file_ygo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardTextSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardTextSearchRequest) ProtoMessage() {}
+
+func (x *CardTextSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardTextSearchRequest.ProtoReflect.Descriptor instead.
+func (*CardTextSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CardTextSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CardTextSearchRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CardTextSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CardTextSearchRequest) GetIncludeHighlights() bool {
+	if x != nil {
+		return x.IncludeHighlights
+	}
+	return false
+}
+
+type CardTextSearchResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*CardTextMatch       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardTextSearchResults) Reset() {
+	*x = CardTextSearchResults{}
+	mi := &file_ygo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardTextSearchResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardTextSearchResults) ProtoMessage() {}
+
+func (x *CardTextSearchResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardTextSearchResults.ProtoReflect.Descriptor instead.
+func (*CardTextSearchResults) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CardTextSearchResults) GetMatches() []*CardTextMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *CardTextSearchResults) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CardTextMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights    []*TextSpan            `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardTextMatch) Reset() {
+	*x = CardTextMatch{}
+	mi := &file_ygo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardTextMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardTextMatch) ProtoMessage() {}
+
+func (x *CardTextMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardTextMatch.ProtoReflect.Descriptor instead.
+func (*CardTextMatch) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CardTextMatch) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *CardTextMatch) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CardTextMatch) GetHighlights() []*TextSpan {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// modified_since is an RFC 3339 timestamp, when empty every card is streamed
+type CardStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModifiedSince string                 `protobuf:"bytes,1,opt,name=modified_since,json=modifiedSince,proto3" json:"modified_since,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardStreamRequest) Reset() {
+	*x = CardStreamRequest{}
+	mi := &file_ygo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardStreamRequest) ProtoMessage() {}
+
+func (x *CardStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardStreamRequest.ProtoReflect.Descriptor instead.
+func (*CardStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CardStreamRequest) GetModifiedSince() string {
+	if x != nil {
+		return x.ModifiedSince
+	}
+	return ""
+}
+
+func (x *CardStreamRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CardReferenceGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Depth         uint32                 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardReferenceGraphRequest) Reset() {
+	*x = CardReferenceGraphRequest{}
+	mi := &file_ygo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardReferenceGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardReferenceGraphRequest) ProtoMessage() {}
+
+func (x *CardReferenceGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardReferenceGraphRequest.ProtoReflect.Descriptor instead.
+func (*CardReferenceGraphRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CardReferenceGraphRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CardReferenceGraphRequest) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// nodes are either cards (keyed by ID) or archetypes (keyed by name). Edges of type ARCHETYPE point to an archetype node.
+type CardReferenceGraph struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         map[string]*Card       `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Archetypes    []string               `protobuf:"bytes,2,rep,name=archetypes,proto3" json:"archetypes,omitempty"`
+	Edges         []*CardReferenceEdge   `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` // true when graph hit the node limit before reaching requested depth
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardReferenceGraph) Reset() {
+	*x = CardReferenceGraph{}
+	mi := &file_ygo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardReferenceGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardReferenceGraph) ProtoMessage() {}
+
+func (x *CardReferenceGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardReferenceGraph.ProtoReflect.Descriptor instead.
+func (*CardReferenceGraph) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CardReferenceGraph) GetCards() map[string]*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardReferenceGraph) GetArchetypes() []string {
+	if x != nil {
+		return x.Archetypes
+	}
+	return nil
+}
+
+func (x *CardReferenceGraph) GetEdges() []*CardReferenceEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *CardReferenceGraph) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type CardReferenceEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Type          CardReferenceType      `protobuf:"varint,3,opt,name=type,proto3,enum=ygo.common.CardReferenceType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardReferenceEdge) Reset() {
+	*x = CardReferenceEdge{}
+	mi := &file_ygo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardReferenceEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardReferenceEdge) ProtoMessage() {}
+
+func (x *CardReferenceEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardReferenceEdge.ProtoReflect.Descriptor instead.
+func (*CardReferenceEdge) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CardReferenceEdge) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CardReferenceEdge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CardReferenceEdge) GetType() CardReferenceType {
+	if x != nil {
+		return x.Type
+	}
+	return CardReferenceType_MENTIONS
+}
+
+// members include cards with the archetype in their name and cards that are always treated as the archetype, minus cards that are not treated as the archetype
+type ArchetypeMembers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archetype     string                 `protobuf:"bytes,1,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	Members       []*ArchetypeMember     `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Excluded      []*Card                `protobuf:"bytes,3,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchetypeMembers) Reset() {
+	*x = ArchetypeMembers{}
+	mi := &file_ygo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchetypeMembers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchetypeMembers) ProtoMessage() {}
+
+func (x *ArchetypeMembers) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchetypeMembers.ProtoReflect.Descriptor instead.
+func (*ArchetypeMembers) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ArchetypeMembers) GetArchetype() string {
+	if x != nil {
+		return x.Archetype
+	}
+	return ""
+}
+
+func (x *ArchetypeMembers) GetMembers() []*ArchetypeMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ArchetypeMembers) GetExcluded() []*Card {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+type ArchetypeMember struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Card          *Card                    `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Reason        ArchetypeInclusionReason `protobuf:"varint,2,opt,name=reason,proto3,enum=ygo.common.ArchetypeInclusionReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchetypeMember) Reset() {
+	*x = ArchetypeMember{}
+	mi := &file_ygo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchetypeMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchetypeMember) ProtoMessage() {}
+
+func (x *ArchetypeMember) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchetypeMember.ProtoReflect.Descriptor instead.
+func (*ArchetypeMember) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ArchetypeMember) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *ArchetypeMember) GetReason() ArchetypeInclusionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ArchetypeInclusionReason_NAME_MATCH
+}
+
+// catalog is rebuilt periodically, refreshed_at is an RFC 3339 timestamp of the last rebuild
+type ArchetypeCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archetypes    []*ArchetypeSummary    `protobuf:"bytes,1,rep,name=archetypes,proto3" json:"archetypes,omitempty"`
+	RefreshedAt   string                 `protobuf:"bytes,2,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchetypeCatalog) Reset() {
+	*x = ArchetypeCatalog{}
+	mi := &file_ygo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchetypeCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchetypeCatalog) ProtoMessage() {}
+
+func (x *ArchetypeCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchetypeCatalog.ProtoReflect.Descriptor instead.
+func (*ArchetypeCatalog) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ArchetypeCatalog) GetArchetypes() []*ArchetypeSummary {
+	if x != nil {
+		return x.Archetypes
+	}
+	return nil
+}
+
+func (x *ArchetypeCatalog) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+type ArchetypeSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount        uint32                 `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	NameMatches        uint32                 `protobuf:"varint,3,opt,name=name_matches,json=nameMatches,proto3" json:"name_matches,omitempty"`
+	ExplicitInclusions uint32                 `protobuf:"varint,4,opt,name=explicit_inclusions,json=explicitInclusions,proto3" json:"explicit_inclusions,omitempty"`
+	ExplicitExclusions uint32                 `protobuf:"varint,5,opt,name=explicit_exclusions,json=explicitExclusions,proto3" json:"explicit_exclusions,omitempty"`
+	ReferencingCards   uint32                 `protobuf:"varint,6,opt,name=referencing_cards,json=referencingCards,proto3" json:"referencing_cards,omitempty"` // cards that reference the archetype in their effect
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ArchetypeSummary) Reset() {
+	*x = ArchetypeSummary{}
+	mi := &file_ygo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchetypeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchetypeSummary) ProtoMessage() {}
+
+func (x *ArchetypeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchetypeSummary.ProtoReflect.Descriptor instead.
+func (*ArchetypeSummary) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ArchetypeSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchetypeSummary) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *ArchetypeSummary) GetNameMatches() uint32 {
+	if x != nil {
+		return x.NameMatches
+	}
+	return 0
+}
+
+func (x *ArchetypeSummary) GetExplicitInclusions() uint32 {
+	if x != nil {
+		return x.ExplicitInclusions
+	}
+	return 0
+}
+
+func (x *ArchetypeSummary) GetExplicitExclusions() uint32 {
+	if x != nil {
+		return x.ExplicitExclusions
+	}
+	return 0
+}
+
+func (x *ArchetypeSummary) GetReferencingCards() uint32 {
+	if x != nil {
+		return x.ReferencingCards
+	}
+	return 0
+}
+
+type CardNameSuggestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardNameSuggestionRequest) Reset() {
+	*x = CardNameSuggestionRequest{}
+	mi := &file_ygo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardNameSuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardNameSuggestionRequest) ProtoMessage() {}
+
+func (x *CardNameSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardNameSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*CardNameSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CardNameSuggestionRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CardNameSuggestionRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CardNameSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardNameSuggestion) Reset() {
+	*x = CardNameSuggestion{}
+	mi := &file_ygo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardNameSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardNameSuggestion) ProtoMessage() {}
+
+func (x *CardNameSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardNameSuggestion.ProtoReflect.Descriptor instead.
+func (*CardNameSuggestion) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CardNameSuggestion) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CardNameSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CardNameSuggestion) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CardNameSuggestions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*CardNameSuggestion  `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardNameSuggestions) Reset() {
+	*x = CardNameSuggestions{}
+	mi := &file_ygo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardNameSuggestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardNameSuggestions) ProtoMessage() {}
+
+func (x *CardNameSuggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardNameSuggestions.ProtoReflect.Descriptor instead.
+func (*CardNameSuggestions) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CardNameSuggestions) GetSuggestions() []*CardNameSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Product struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ID                 string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Locale             string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SubType            string                 `protobuf:"bytes,5,opt,name=subType,proto3" json:"subType,omitempty"`
+	ReleaseDate        string                 `protobuf:"bytes,6,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	TotalItems         uint32                 `protobuf:"varint,7,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+	Items              []*ProductItem         `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	RarityDistribution map[string]uint32      `protobuf:"bytes,9,rep,name=rarityDistribution,proto3" json:"rarityDistribution,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	NewItems           uint32                 `protobuf:"varint,10,opt,name=newItems,proto3" json:"newItems,omitempty"` // items printed for the first time in this product
+	ReprintedItems     uint32                 `protobuf:"varint,11,opt,name=reprintedItems,proto3" json:"reprintedItems,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_ygo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Product) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Product) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *Product) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Product) GetTotalItems() uint32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *Product) GetItems() []*ProductItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Product) GetRarityDistribution() map[string]uint32 {
+	if x != nil {
+		return x.RarityDistribution
+	}
+	return nil
+}
+
+func (x *Product) GetNewItems() uint32 {
+	if x != nil {
+		return x.NewItems
+	}
+	return 0
+}
+
+func (x *Product) GetReprintedItems() uint32 {
+	if x != nil {
+		return x.ReprintedItems
+	}
+	return 0
+}
+
+type ProductItem struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Card                   *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Position               string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Rarities               []string               `protobuf:"bytes,3,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	FirstPrinting          bool                   `protobuf:"varint,4,opt,name=firstPrinting,proto3" json:"firstPrinting,omitempty"`
+	FirstPrintingProductID string                 `protobuf:"bytes,5,opt,name=firstPrintingProductID,proto3" json:"firstPrintingProductID,omitempty"` // earliest product containing the card, only set for reprints
+	FirstPrintingDate      string                 `protobuf:"bytes,6,opt,name=firstPrintingDate,proto3" json:"firstPrintingDate,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ProductItem) Reset() {
+	*x = ProductItem{}
+	mi := &file_ygo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductItem) ProtoMessage() {}
+
+func (x *ProductItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductItem.ProtoReflect.Descriptor instead.
+func (*ProductItem) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ProductItem) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *ProductItem) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ProductItem) GetRarities() []string {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+func (x *ProductItem) GetFirstPrinting() bool {
+	if x != nil {
+		return x.FirstPrinting
+	}
+	return false
+}
+
+func (x *ProductItem) GetFirstPrintingProductID() string {
+	if x != nil {
+		return x.FirstPrintingProductID
+	}
+	return ""
+}
+
+func (x *ProductItem) GetFirstPrintingDate() string {
+	if x != nil {
+		return x.FirstPrintingDate
+	}
+	return ""
+}
+
+type ProductSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SubType       string                 `protobuf:"bytes,5,opt,name=subType,proto3" json:"subType,omitempty"`
+	ReleaseDate   string                 `protobuf:"bytes,6,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	TotalItems    uint32                 `protobuf:"varint,7,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSummary) Reset() {
+	*x = ProductSummary{}
+	mi := &file_ygo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSummary) ProtoMessage() {}
+
+func (x *ProductSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSummary.ProtoReflect.Descriptor instead.
+func (*ProductSummary) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProductSummary) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetTotalItems() uint32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+type Products struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Products         map[string]*ProductSummary `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UnknownResources []string                   `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Products) Reset() {
+	*x = Products{}
+	mi := &file_ygo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Products) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Products) ProtoMessage() {}
+
+func (x *Products) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Products.ProtoReflect.Descriptor instead.
+func (*Products) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Products) GetProducts() map[string]*ProductSummary {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *Products) GetUnknownResources() []string {
+	if x != nil {
+		return x.UnknownResources
+	}
+	return nil
+}
+
+type ProductSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *ProductSearchFilter   `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortOrder     ProductSortOrder       `protobuf:"varint,2,opt,name=sort_order,json=sortOrder,proto3,enum=ygo.common.ProductSortOrder" json:"sort_order,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSearchRequest) Reset() {
+	*x = ProductSearchRequest{}
+	mi := &file_ygo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSearchRequest) ProtoMessage() {}
+
+func (x *ProductSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSearchRequest.ProtoReflect.Descriptor instead.
+func (*ProductSearchRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProductSearchRequest) GetFilter() *ProductSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ProductSearchRequest) GetSortOrder() ProductSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ProductSortOrder_RELEASE_DATE_DESC
+}
+
+func (x *ProductSearchRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ProductSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// release dates use YYYY-MM-DD and are inclusive
+type ProductSearchFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Types          []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	SubTypes       []string               `protobuf:"bytes,2,rep,name=sub_types,json=subTypes,proto3" json:"sub_types,omitempty"`
+	Locales        []string               `protobuf:"bytes,3,rep,name=locales,proto3" json:"locales,omitempty"`
+	ReleasedAfter  string                 `protobuf:"bytes,4,opt,name=released_after,json=releasedAfter,proto3" json:"released_after,omitempty"`
+	ReleasedBefore string                 `protobuf:"bytes,5,opt,name=released_before,json=releasedBefore,proto3" json:"released_before,omitempty"`
+	Name           string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"` // matches any part of the product name
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductSearchFilter) Reset() {
+	*x = ProductSearchFilter{}
+	mi := &file_ygo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSearchFilter) ProtoMessage() {}
+
+func (x *ProductSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSearchFilter.ProtoReflect.Descriptor instead.
+func (*ProductSearchFilter) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ProductSearchFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ProductSearchFilter) GetSubTypes() []string {
+	if x != nil {
+		return x.SubTypes
+	}
+	return nil
+}
+
+func (x *ProductSearchFilter) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *ProductSearchFilter) GetReleasedAfter() string {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return ""
+}
+
+func (x *ProductSearchFilter) GetReleasedBefore() string {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return ""
+}
+
+func (x *ProductSearchFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ProductSearchResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductSummary      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSearchResults) Reset() {
+	*x = ProductSearchResults{}
+	mi := &file_ygo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSearchResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSearchResults) ProtoMessage() {}
+
+func (x *ProductSearchResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSearchResults.ProtoReflect.Descriptor instead.
+func (*ProductSearchResults) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProductSearchResults) GetProducts() []*ProductSummary {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ProductSearchResults) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// keyed by print code as requested, ie: LOB-EN001
+type PrintedCards struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	PrintedCards     map[string]*PrintedCard `protobuf:"bytes,1,rep,name=printed_cards,json=printedCards,proto3" json:"printed_cards,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UnknownResources []string                `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PrintedCards) Reset() {
+	*x = PrintedCards{}
+	mi := &file_ygo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintedCards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintedCards) ProtoMessage() {}
+
+func (x *PrintedCards) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintedCards.ProtoReflect.Descriptor instead.
+func (*PrintedCards) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PrintedCards) GetPrintedCards() map[string]*PrintedCard {
+	if x != nil {
+		return x.PrintedCards
+	}
+	return nil
+}
+
+func (x *PrintedCards) GetUnknownResources() []string {
+	if x != nil {
+		return x.UnknownResources
+	}
+	return nil
+}
+
+type PrintedCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Product       *ProductSummary        `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Position      string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Rarities      []string               `protobuf:"bytes,4,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrintedCard) Reset() {
+	*x = PrintedCard{}
+	mi := &file_ygo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintedCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintedCard) ProtoMessage() {}
+
+func (x *PrintedCard) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintedCard.ProtoReflect.Descriptor instead.
+func (*PrintedCard) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PrintedCard) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *PrintedCard) GetProduct() *ProductSummary {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *PrintedCard) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *PrintedCard) GetRarities() []string {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+// products a card was printed in, oldest release first
+type CardPrintings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Printings     []*CardPrinting        `protobuf:"bytes,2,rep,name=printings,proto3" json:"printings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardPrintings) Reset() {
+	*x = CardPrintings{}
+	mi := &file_ygo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardPrintings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardPrintings) ProtoMessage() {}
+
+func (x *CardPrintings) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardPrintings.ProtoReflect.Descriptor instead.
+func (*CardPrintings) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CardPrintings) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *CardPrintings) GetPrintings() []*CardPrinting {
+	if x != nil {
+		return x.Printings
+	}
+	return nil
+}
+
+type CardPrinting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductSummary        `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Position      string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Rarities      []string               `protobuf:"bytes,3,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardPrinting) Reset() {
+	*x = CardPrinting{}
+	mi := &file_ygo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardPrinting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardPrinting) ProtoMessage() {}
+
+func (x *CardPrinting) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardPrinting.ProtoReflect.Descriptor instead.
+func (*CardPrinting) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CardPrinting) GetProduct() *ProductSummary {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *CardPrinting) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CardPrinting) GetRarities() []string {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+// cards that were never printed are unknown resources
+type BatchCardPrintings struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	CardInfo         map[string]*CardPrintings `protobuf:"bytes,1,rep,name=card_info,json=cardInfo,proto3" json:"card_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UnknownResources []string                  `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchCardPrintings) Reset() {
+	*x = BatchCardPrintings{}
+	mi := &file_ygo_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCardPrintings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCardPrintings) ProtoMessage() {}
+
+func (x *BatchCardPrintings) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCardPrintings.ProtoReflect.Descriptor instead.
+func (*BatchCardPrintings) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Product) GetItems() []*ProductItem {
+func (x *BatchCardPrintings) GetCardInfo() map[string]*CardPrintings {
 	if x != nil {
-		return x.Items
+		return x.CardInfo
 	}
 	return nil
 }
 
-func (x *Product) GetRarityDistribution() map[string]uint32 {
+func (x *BatchCardPrintings) GetUnknownResources() []string {
 	if x != nil {
-		return x.RarityDistribution
+		return x.UnknownResources
 	}
 	return nil
 }
 
-type ProductItem struct {
+type Format struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
-	Position      string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	Rarities      []string               `protobuf:"bytes,3,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductItem) Reset() {
-	*x = ProductItem{}
-	mi := &file_ygo_service_proto_msgTypes[5]
+func (x *Format) Reset() {
+	*x = Format{}
+	mi := &file_ygo_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductItem) String() string {
+func (x *Format) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductItem) ProtoMessage() {}
+func (*Format) ProtoMessage() {}
 
-func (x *ProductItem) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[5]
+func (x *Format) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,60 +3624,42 @@ func (x *ProductItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductItem.ProtoReflect.Descriptor instead.
-func (*ProductItem) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ProductItem) GetCard() *Card {
-	if x != nil {
-		return x.Card
-	}
-	return nil
+// Deprecated: Use Format.ProtoReflect.Descriptor instead.
+func (*Format) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ProductItem) GetPosition() string {
+func (x *Format) GetValue() string {
 	if x != nil {
-		return x.Position
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ProductItem) GetRarities() []string {
-	if x != nil {
-		return x.Rarities
-	}
-	return nil
-}
-
-type ProductSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	SubType       string                 `protobuf:"bytes,5,opt,name=subType,proto3" json:"subType,omitempty"`
-	ReleaseDate   string                 `protobuf:"bytes,6,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
-	TotalItems    uint32                 `protobuf:"varint,7,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
+type RestrictedContentRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Format        string                   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	EffectiveDate string                   `protobuf:"bytes,2,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	SortOrder     CardRestrictionSortOrder `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=ygo.common.CardRestrictionSortOrder" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductSummary) Reset() {
-	*x = ProductSummary{}
-	mi := &file_ygo_service_proto_msgTypes[6]
+func (x *RestrictedContentRequest) Reset() {
+	*x = RestrictedContentRequest{}
+	mi := &file_ygo_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductSummary) String() string {
+func (x *RestrictedContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductSummary) ProtoMessage() {}
+func (*RestrictedContentRequest) ProtoMessage() {}
 
-func (x *ProductSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[6]
+func (x *RestrictedContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,83 +3670,103 @@ func (x *ProductSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductSummary.ProtoReflect.Descriptor instead.
-func (*ProductSummary) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RestrictedContentRequest.ProtoReflect.Descriptor instead.
+func (*RestrictedContentRequest) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ProductSummary) GetID() string {
+func (x *RestrictedContentRequest) GetFormat() string {
 	if x != nil {
-		return x.ID
+		return x.Format
 	}
 	return ""
 }
 
-func (x *ProductSummary) GetLocale() string {
+func (x *RestrictedContentRequest) GetEffectiveDate() string {
 	if x != nil {
-		return x.Locale
+		return x.EffectiveDate
 	}
 	return ""
 }
 
-func (x *ProductSummary) GetName() string {
+func (x *RestrictedContentRequest) GetSortOrder() CardRestrictionSortOrder {
 	if x != nil {
-		return x.Name
+		return x.SortOrder
 	}
-	return ""
+	return CardRestrictionSortOrder_CARD_COLOR_ASC_CARD_NAME_ASC
 }
 
-func (x *ProductSummary) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// catalog specific data types
+type CatalogEntries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CatalogEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductSummary) GetSubType() string {
-	if x != nil {
-		return x.SubType
-	}
-	return ""
+func (x *CatalogEntries) Reset() {
+	*x = CatalogEntries{}
+	mi := &file_ygo_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductSummary) GetReleaseDate() string {
+func (x *CatalogEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEntries) ProtoMessage() {}
+
+func (x *CatalogEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[54]
 	if x != nil {
-		return x.ReleaseDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductSummary) GetTotalItems() uint32 {
+// Deprecated: Use CatalogEntries.ProtoReflect.Descriptor instead.
+func (*CatalogEntries) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CatalogEntries) GetEntries() []*CatalogEntry {
 	if x != nil {
-		return x.TotalItems
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-type Products struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	Products         map[string]*ProductSummary `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UnknownResources []string                   `protobuf:"bytes,2,rep,name=unknown_resources,json=unknownResources,proto3" json:"unknown_resources,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// parent is only used by entries that belong to another entry (ie: product sub types belong to a product type)
+type CatalogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	DisplayOrder  uint32                 `protobuf:"varint,3,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	Parent        string                 `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Products) Reset() {
-	*x = Products{}
-	mi := &file_ygo_service_proto_msgTypes[7]
+func (x *CatalogEntry) Reset() {
+	*x = CatalogEntry{}
+	mi := &file_ygo_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Products) String() string {
+func (x *CatalogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Products) ProtoMessage() {}
+func (*CatalogEntry) ProtoMessage() {}
 
-func (x *Products) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[7]
+func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,47 +3777,61 @@ func (x *Products) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Products.ProtoReflect.Descriptor instead.
-func (*Products) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CatalogEntry.ProtoReflect.Descriptor instead.
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Products) GetProducts() map[string]*ProductSummary {
+func (x *CatalogEntry) GetName() string {
 	if x != nil {
-		return x.Products
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Products) GetUnknownResources() []string {
+func (x *CatalogEntry) GetCount() uint32 {
 	if x != nil {
-		return x.UnknownResources
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type Format struct {
+func (x *CatalogEntry) GetDisplayOrder() uint32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *CatalogEntry) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type CardColorCatalog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Colors        []*CardColorEntry      `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Format) Reset() {
-	*x = Format{}
-	mi := &file_ygo_service_proto_msgTypes[8]
+func (x *CardColorCatalog) Reset() {
+	*x = CardColorCatalog{}
+	mi := &file_ygo_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Format) String() string {
+func (x *CardColorCatalog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Format) ProtoMessage() {}
+func (*CardColorCatalog) ProtoMessage() {}
 
-func (x *Format) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[8]
+func (x *CardColorCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,42 +3842,45 @@ func (x *Format) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Format.ProtoReflect.Descriptor instead.
-func (*Format) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CardColorCatalog.ProtoReflect.Descriptor instead.
+func (*CardColorCatalog) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Format) GetValue() string {
+func (x *CardColorCatalog) GetColors() []*CardColorEntry {
 	if x != nil {
-		return x.Value
+		return x.Colors
 	}
-	return ""
+	return nil
 }
 
-type RestrictedContentRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Format        string                   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
-	EffectiveDate string                   `protobuf:"bytes,2,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
-	SortOrder     CardRestrictionSortOrder `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=ygo.common.CardRestrictionSortOrder" json:"sort_order,omitempty"`
+type CardColorEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	DisplayOrder  uint32                 `protobuf:"varint,4,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	DeckLocation  DeckLocation           `protobuf:"varint,5,opt,name=deck_location,json=deckLocation,proto3,enum=ygo.common.DeckLocation" json:"deck_location,omitempty"`
+	Category      CardCategory           `protobuf:"varint,6,opt,name=category,proto3,enum=ygo.common.CardCategory" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestrictedContentRequest) Reset() {
-	*x = RestrictedContentRequest{}
-	mi := &file_ygo_service_proto_msgTypes[9]
+func (x *CardColorEntry) Reset() {
+	*x = CardColorEntry{}
+	mi := &file_ygo_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestrictedContentRequest) String() string {
+func (x *CardColorEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestrictedContentRequest) ProtoMessage() {}
+func (*CardColorEntry) ProtoMessage() {}
 
-func (x *RestrictedContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[9]
+func (x *CardColorEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ygo_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,30 +3891,51 @@ func (x *RestrictedContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestrictedContentRequest.ProtoReflect.Descriptor instead.
-func (*RestrictedContentRequest) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CardColorEntry.ProtoReflect.Descriptor instead.
+func (*CardColorEntry) Descriptor() ([]byte, []int) {
+	return file_ygo_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RestrictedContentRequest) GetFormat() string {
+func (x *CardColorEntry) GetID() uint32 {
 	if x != nil {
-		return x.Format
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *RestrictedContentRequest) GetEffectiveDate() string {
+func (x *CardColorEntry) GetName() string {
 	if x != nil {
-		return x.EffectiveDate
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RestrictedContentRequest) GetSortOrder() CardRestrictionSortOrder {
+func (x *CardColorEntry) GetCount() uint32 {
 	if x != nil {
-		return x.SortOrder
+		return x.Count
 	}
-	return CardRestrictionSortOrder_CARD_COLOR_ASC_CARD_NAME_ASC
+	return 0
+}
+
+func (x *CardColorEntry) GetDisplayOrder() uint32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *CardColorEntry) GetDeckLocation() DeckLocation {
+	if x != nil {
+		return x.DeckLocation
+	}
+	return DeckLocation_MAIN_DECK
+}
+
+func (x *CardColorEntry) GetCategory() CardCategory {
+	if x != nil {
+		return x.Category
+	}
+	return CardCategory_MONSTER
 }
 
 type ScoresForFormatAndDate struct {
@@ -693,7 +3952,7 @@ type ScoresForFormatAndDate struct {
 
 func (x *ScoresForFormatAndDate) Reset() {
 	*x = ScoresForFormatAndDate{}
-	mi := &file_ygo_service_proto_msgTypes[10]
+	mi := &file_ygo_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +3964,7 @@ func (x *ScoresForFormatAndDate) String() string {
 func (*ScoresForFormatAndDate) ProtoMessage() {}
 
 func (x *ScoresForFormatAndDate) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[10]
+	mi := &file_ygo_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +3977,7 @@ func (x *ScoresForFormatAndDate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoresForFormatAndDate.ProtoReflect.Descriptor instead.
 func (*ScoresForFormatAndDate) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{10}
+	return file_ygo_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ScoresForFormatAndDate) GetFormat() string {
@@ -773,7 +4032,7 @@ type CardScoreEntry struct {
 
 func (x *CardScoreEntry) Reset() {
 	*x = CardScoreEntry{}
-	mi := &file_ygo_service_proto_msgTypes[11]
+	mi := &file_ygo_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +4044,7 @@ func (x *CardScoreEntry) String() string {
 func (*CardScoreEntry) ProtoMessage() {}
 
 func (x *CardScoreEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[11]
+	mi := &file_ygo_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +4057,7 @@ func (x *CardScoreEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardScoreEntry.ProtoReflect.Descriptor instead.
 func (*CardScoreEntry) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{11}
+	return file_ygo_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *CardScoreEntry) GetCard() *Card {
@@ -827,7 +4086,7 @@ type CardScore struct {
 
 func (x *CardScore) Reset() {
 	*x = CardScore{}
-	mi := &file_ygo_service_proto_msgTypes[12]
+	mi := &file_ygo_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +4098,7 @@ func (x *CardScore) String() string {
 func (*CardScore) ProtoMessage() {}
 
 func (x *CardScore) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[12]
+	mi := &file_ygo_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +4111,7 @@ func (x *CardScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardScore.ProtoReflect.Descriptor instead.
 func (*CardScore) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{12}
+	return file_ygo_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CardScore) GetCurrentScoreByFormat() map[string]uint32 {
@@ -893,7 +4152,7 @@ type CardScores struct {
 
 func (x *CardScores) Reset() {
 	*x = CardScores{}
-	mi := &file_ygo_service_proto_msgTypes[13]
+	mi := &file_ygo_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -905,7 +4164,7 @@ func (x *CardScores) String() string {
 func (*CardScores) ProtoMessage() {}
 
 func (x *CardScores) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[13]
+	mi := &file_ygo_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +4177,7 @@ func (x *CardScores) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardScores.ProtoReflect.Descriptor instead.
 func (*CardScores) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{13}
+	return file_ygo_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *CardScores) GetCardInfo() map[string]*CardScore {
@@ -946,7 +4205,7 @@ type ScoreEntry struct {
 
 func (x *ScoreEntry) Reset() {
 	*x = ScoreEntry{}
-	mi := &file_ygo_service_proto_msgTypes[14]
+	mi := &file_ygo_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +4217,7 @@ func (x *ScoreEntry) String() string {
 func (*ScoreEntry) ProtoMessage() {}
 
 func (x *ScoreEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_ygo_service_proto_msgTypes[14]
+	mi := &file_ygo_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +4230,7 @@ func (x *ScoreEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoreEntry.ProtoReflect.Descriptor instead.
 func (*ScoreEntry) Descriptor() ([]byte, []int) {
-	return file_ygo_service_proto_rawDescGZIP(), []int{14}
+	return file_ygo_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ScoreEntry) GetFormat() string {
@@ -1005,7 +4264,7 @@ const file_ygo_service_proto_rawDesc = "" +
 	"\x06values\x18\x01 \x03(\v2\x1b.ygo.CardColors.ValuesEntryR\x06values\x1a9\n" +
 	"\vValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"\xa5\x02\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"\xd2\x05\n" +
 	"\x04Card\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x14\n" +
 	"\x05color\x18\x02 \x01(\tR\x05color\x12\x12\n" +
@@ -1014,15 +4273,228 @@ const file_ygo_service_proto_rawDesc = "" +
 	"\x06effect\x18\x05 \x01(\tR\x06effect\x12?\n" +
 	"\fmonster_type\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\vmonsterType\x124\n" +
 	"\x06attack\x18\a \x01(\v2\x1c.google.protobuf.UInt32ValueR\x06attack\x126\n" +
-	"\adefense\x18\b \x01(\v2\x1c.google.protobuf.UInt32ValueR\adefense\"\xb3\x01\n" +
+	"\adefense\x18\b \x01(\v2\x1c.google.protobuf.UInt32ValueR\adefense\x12,\n" +
+	"\tmaterials\x18\t \x01(\v2\x0e.ygo.MaterialsR\tmaterials\x12C\n" +
+	"\x0ependulum_scale\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.UInt32ValueR\rpendulumScale\x12E\n" +
+	"\x0fpendulum_effect\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x0ependulumEffect\x12C\n" +
+	"\x0emonster_effect\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\rmonsterEffect\x12B\n" +
+	"\x14monster_type_details\x18\r \x01(\v2\x10.ygo.MonsterTypeR\x12monsterTypeDetails\x12?\n" +
+	"\x0eclassification\x18\x0e \x01(\v2\x17.ygo.CardClassificationR\x0eclassification\x12'\n" +
+	"\x04tags\x18\x0f \x03(\v2\x13.ygo.EffectTagMatchR\x04tags\";\n" +
+	"\x13SimilarCardsRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"Y\n" +
+	"\fSimilarCards\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12*\n" +
+	"\asimilar\x18\x02 \x03(\v2\x10.ygo.SimilarCardR\asimilar\"B\n" +
+	"\vSimilarCard\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"\xa1\x01\n" +
+	"\x13CardEffectBreakdown\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12,\n" +
+	"\x06effect\x18\x02 \x01(\v2\x14.ygo.EffectBreakdownR\x06effect\x12=\n" +
+	"\x0fpendulum_effect\x18\x03 \x01(\v2\x14.ygo.EffectBreakdownR\x0ependulumEffect\"\x91\x01\n" +
+	"\x0fEffectBreakdown\x12+\n" +
+	"\aeffects\x18\x01 \x03(\v2\x11.ygo.EffectClauseR\aeffects\x12\"\n" +
+	"\frestrictions\x18\x02 \x03(\tR\frestrictions\x12-\n" +
+	"\x12activation_limited\x18\x03 \x01(\bR\x11activationLimited\"\xc9\x01\n" +
+	"\fEffectClause\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\rR\x06number\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\tR\x04cost\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\tR\n" +
+	"resolution\x12;\n" +
+	"\ronce_per_turn\x18\x06 \x01(\x0e2\x17.ygo.common.OncePerTurnR\voncePerTurn\"^\n" +
+	"\x0eEffectTagMatch\x12'\n" +
+	"\x03tag\x18\x01 \x01(\x0e2\x15.ygo.common.EffectTagR\x03tag\x12#\n" +
+	"\x05spans\x18\x02 \x03(\v2\r.ygo.TextSpanR\x05spans\"\xd2\x01\n" +
+	"\x12CardClassification\x12+\n" +
+	"\x05frame\x18\x01 \x01(\x0e2\x15.ygo.common.CardFrameR\x05frame\x12\x1a\n" +
+	"\bpendulum\x18\x02 \x01(\bR\bpendulum\x12=\n" +
+	"\rdeck_location\x18\x03 \x01(\x0e2\x18.ygo.common.DeckLocationR\fdeckLocation\x124\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x18.ygo.common.CardCategoryR\bcategory\"\xe1\x02\n" +
+	"\vMonsterType\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05tuner\x18\x02 \x01(\bR\x05tuner\x12\x12\n" +
+	"\x04flip\x18\x03 \x01(\bR\x04flip\x12\x16\n" +
+	"\x06gemini\x18\x04 \x01(\bR\x06gemini\x12\x16\n" +
+	"\x06spirit\x18\x05 \x01(\bR\x06spirit\x12\x14\n" +
+	"\x05union\x18\x06 \x01(\bR\x05union\x12\x12\n" +
+	"\x04toon\x18\a \x01(\bR\x04toon\x12\x16\n" +
+	"\x06normal\x18\b \x01(\bR\x06normal\x12\x16\n" +
+	"\x06effect\x18\t \x01(\bR\x06effect\x12\x16\n" +
+	"\x06ritual\x18\n" +
+	" \x01(\bR\x06ritual\x12\x16\n" +
+	"\x06fusion\x18\v \x01(\bR\x06fusion\x12\x18\n" +
+	"\asynchro\x18\f \x01(\bR\asynchro\x12\x10\n" +
+	"\x03xyz\x18\r \x01(\bR\x03xyz\x12\x1a\n" +
+	"\bpendulum\x18\x0e \x01(\bR\bpendulum\x12\x12\n" +
+	"\x04link\x18\x0f \x01(\bR\x04link\"\xb5\x01\n" +
+	"\tMaterials\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
+	"\tmin_count\x18\x02 \x01(\rR\bminCount\x129\n" +
+	"\tmax_count\x18\x03 \x01(\v2\x1c.google.protobuf.UInt32ValueR\bmaxCount\x12<\n" +
+	"\frequirements\x18\x04 \x03(\v2\x18.ygo.MaterialRequirementR\frequirements\"\xa2\x03\n" +
+	"\x13MaterialRequirement\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x17\n" +
+	"\aor_more\x18\x03 \x01(\bR\x06orMore\x12\x14\n" +
+	"\x05names\x18\x04 \x03(\tR\x05names\x12\x1c\n" +
+	"\tarchetype\x18\x05 \x01(\tR\tarchetype\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\tR\n" +
+	"attributes\x12\x14\n" +
+	"\x05types\x18\a \x03(\tR\x05types\x12\x16\n" +
+	"\x06frames\x18\b \x03(\tR\x06frames\x122\n" +
+	"\x05tuner\x18\t \x01(\x0e2\x1c.ygo.common.TunerRequirementR\x05tuner\x129\n" +
+	"\tmin_level\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.UInt32ValueR\bminLevel\x129\n" +
+	"\tmax_level\x18\v \x01(\v2\x1c.google.protobuf.UInt32ValueR\bmaxLevel\x12\x1c\n" +
+	"\tinclusion\x18\f \x01(\bR\tinclusion\"\xa9\x01\n" +
+	"\x0eMaterialUsages\x12%\n" +
+	"\bmaterial\x18\x01 \x01(\v2\t.ygo.CardR\bmaterial\x124\n" +
+	"\x10named_explicitly\x18\x02 \x03(\v2\t.ygo.CardR\x0fnamedExplicitly\x12:\n" +
+	"\x13matches_generically\x18\x03 \x03(\v2\t.ygo.CardR\x12matchesGenerically\"\xcc\x02\n" +
 	"\x05Cards\x125\n" +
 	"\tcard_info\x18\x01 \x03(\v2\x18.ygo.Cards.CardInfoEntryR\bcardInfo\x12+\n" +
-	"\x11unknown_resources\x18\x02 \x03(\tR\x10unknownResources\x1aF\n" +
+	"\x11unknown_resources\x18\x02 \x03(\tR\x10unknownResources\x12=\n" +
+	"\vsuggestions\x18\x03 \x03(\v2\x1b.ygo.Cards.SuggestionsEntryR\vsuggestions\x1aF\n" +
 	"\rCardInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
-	"\x05value\x18\x02 \x01(\v2\t.ygo.CardR\x05value:\x028\x01\"+\n" +
+	"\x05value\x18\x02 \x01(\v2\t.ygo.CardR\x05value:\x028\x01\x1aX\n" +
+	"\x10SuggestionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.ygo.CardNameSuggestionsR\x05value:\x028\x01\"\xb9\x01\n" +
 	"\bCardList\x12\x1f\n" +
-	"\x05cards\x18\x01 \x03(\v2\t.ygo.CardR\x05cards\"\xfa\x02\n" +
+	"\x05cards\x18\x01 \x03(\v2\t.ygo.CardR\x05cards\x12=\n" +
+	"\n" +
+	"highlights\x18\x02 \x03(\v2\x1d.ygo.CardList.HighlightsEntryR\n" +
+	"highlights\x1aM\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.ygo.TextSpansR\x05value:\x028\x01\"2\n" +
+	"\bTextSpan\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\rR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\rR\x03end\"0\n" +
+	"\tTextSpans\x12#\n" +
+	"\x05spans\x18\x01 \x03(\v2\r.ygo.TextSpanR\x05spans\"B\n" +
+	"\vRandomCards\x12\x1f\n" +
+	"\x05cards\x18\x01 \x03(\v2\t.ygo.CardR\x05cards\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x04R\x04seed\")\n" +
+	"\x13CardOfTheDayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"A\n" +
+	"\fCardOfTheDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\x04card\x18\x02 \x01(\v2\t.ygo.CardR\x04card\"\xb1\x01\n" +
+	"\x11CardSearchRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.ygo.CardSearchFilterR\x06filter\x128\n" +
+	"\n" +
+	"sort_order\x18\x02 \x01(\x0e2\x19.ygo.common.CardSortOrderR\tsortOrder\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\xbb\x02\n" +
+	"\x10CardSearchFilter\x12\x16\n" +
+	"\x06colors\x18\x01 \x03(\tR\x06colors\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\tR\n" +
+	"attributes\x12#\n" +
+	"\rmonster_types\x18\x03 \x03(\tR\fmonsterTypes\x12&\n" +
+	"\x06attack\x18\x04 \x01(\v2\x0e.ygo.StatRangeR\x06attack\x12(\n" +
+	"\adefense\x18\x05 \x01(\v2\x0e.ygo.StatRangeR\adefense\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x129\n" +
+	"\fmonster_type\x18\a \x01(\v2\x16.ygo.MonsterTypeFilterR\vmonsterType\x12)\n" +
+	"\x04tags\x18\b \x03(\x0e2\x15.ygo.common.EffectTagR\x04tags\"\xef\x05\n" +
+	"\x11MonsterTypeFilter\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x120\n" +
+	"\x05tuner\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\x05tuner\x12.\n" +
+	"\x04flip\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x04flip\x122\n" +
+	"\x06gemini\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x06gemini\x122\n" +
+	"\x06spirit\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x06spirit\x120\n" +
+	"\x05union\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueR\x05union\x12.\n" +
+	"\x04toon\x18\a \x01(\v2\x1a.google.protobuf.BoolValueR\x04toon\x122\n" +
+	"\x06normal\x18\b \x01(\v2\x1a.google.protobuf.BoolValueR\x06normal\x122\n" +
+	"\x06effect\x18\t \x01(\v2\x1a.google.protobuf.BoolValueR\x06effect\x122\n" +
+	"\x06ritual\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.BoolValueR\x06ritual\x122\n" +
+	"\x06fusion\x18\v \x01(\v2\x1a.google.protobuf.BoolValueR\x06fusion\x124\n" +
+	"\asynchro\x18\f \x01(\v2\x1a.google.protobuf.BoolValueR\asynchro\x12,\n" +
+	"\x03xyz\x18\r \x01(\v2\x1a.google.protobuf.BoolValueR\x03xyz\x126\n" +
+	"\bpendulum\x18\x0e \x01(\v2\x1a.google.protobuf.BoolValueR\bpendulum\x12.\n" +
+	"\x04link\x18\x0f \x01(\v2\x1a.google.protobuf.BoolValueR\x04link\"\x94\x01\n" +
+	"\tStatRange\x12.\n" +
+	"\x03min\x18\x01 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x03min\x12.\n" +
+	"\x03max\x18\x02 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x03max\x12'\n" +
+	"\x0finclude_unknown\x18\x03 \x01(\bR\x0eincludeUnknown\"U\n" +
+	"\x11CardSearchResults\x12\x1f\n" +
+	"\x05cards\x18\x01 \x03(\v2\t.ygo.CardR\x05cards\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x91\x01\n" +
+	"\x15CardTextSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12-\n" +
+	"\x12include_highlights\x18\x04 \x01(\bR\x11includeHighlights\"f\n" +
+	"\x15CardTextSearchResults\x12,\n" +
+	"\amatches\x18\x01 \x03(\v2\x12.ygo.CardTextMatchR\amatches\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"s\n" +
+	"\rCardTextMatch\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x12-\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\r.ygo.TextSpanR\n" +
+	"highlights\"W\n" +
+	"\x11CardStreamRequest\x12%\n" +
+	"\x0emodified_since\x18\x01 \x01(\tR\rmodifiedSince\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"A\n" +
+	"\x19CardReferenceGraphRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\rR\x05depth\"\xff\x01\n" +
+	"\x12CardReferenceGraph\x128\n" +
+	"\x05cards\x18\x01 \x03(\v2\".ygo.CardReferenceGraph.CardsEntryR\x05cards\x12\x1e\n" +
+	"\n" +
+	"archetypes\x18\x02 \x03(\tR\n" +
+	"archetypes\x12,\n" +
+	"\x05edges\x18\x03 \x03(\v2\x16.ygo.CardReferenceEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x1aC\n" +
+	"\n" +
+	"CardsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\x05value\x18\x02 \x01(\v2\t.ygo.CardR\x05value:\x028\x01\"v\n" +
+	"\x11CardReferenceEdge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x121\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1d.ygo.common.CardReferenceTypeR\x04type\"\x87\x01\n" +
+	"\x10ArchetypeMembers\x12\x1c\n" +
+	"\tarchetype\x18\x01 \x01(\tR\tarchetype\x12.\n" +
+	"\amembers\x18\x02 \x03(\v2\x14.ygo.ArchetypeMemberR\amembers\x12%\n" +
+	"\bexcluded\x18\x03 \x03(\v2\t.ygo.CardR\bexcluded\"n\n" +
+	"\x0fArchetypeMember\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12<\n" +
+	"\x06reason\x18\x02 \x01(\x0e2$.ygo.common.ArchetypeInclusionReasonR\x06reason\"l\n" +
+	"\x10ArchetypeCatalog\x125\n" +
+	"\n" +
+	"archetypes\x18\x01 \x03(\v2\x15.ygo.ArchetypeSummaryR\n" +
+	"archetypes\x12!\n" +
+	"\frefreshed_at\x18\x02 \x01(\tR\vrefreshedAt\"\xfb\x01\n" +
+	"\x10ArchetypeSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\rR\vmemberCount\x12!\n" +
+	"\fname_matches\x18\x03 \x01(\rR\vnameMatches\x12/\n" +
+	"\x13explicit_inclusions\x18\x04 \x01(\rR\x12explicitInclusions\x12/\n" +
+	"\x13explicit_exclusions\x18\x05 \x01(\rR\x12explicitExclusions\x12+\n" +
+	"\x11referencing_cards\x18\x06 \x01(\rR\x10referencingCards\"G\n" +
+	"\x19CardNameSuggestionRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"N\n" +
+	"\x12CardNameSuggestion\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\"P\n" +
+	"\x13CardNameSuggestions\x129\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x17.ygo.CardNameSuggestionR\vsuggestions\"\xbe\x03\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x16\n" +
 	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x12\n" +
@@ -1034,14 +4506,20 @@ const file_ygo_service_proto_rawDesc = "" +
 	"totalItems\x18\a \x01(\rR\n" +
 	"totalItems\x12&\n" +
 	"\x05items\x18\b \x03(\v2\x10.ygo.ProductItemR\x05items\x12T\n" +
-	"\x12rarityDistribution\x18\t \x03(\v2$.ygo.Product.RarityDistributionEntryR\x12rarityDistribution\x1aE\n" +
+	"\x12rarityDistribution\x18\t \x03(\v2$.ygo.Product.RarityDistributionEntryR\x12rarityDistribution\x12\x1a\n" +
+	"\bnewItems\x18\n" +
+	" \x01(\rR\bnewItems\x12&\n" +
+	"\x0ereprintedItems\x18\v \x01(\rR\x0ereprintedItems\x1aE\n" +
 	"\x17RarityDistributionEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"d\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"\xf0\x01\n" +
 	"\vProductItem\x12\x1d\n" +
 	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12\x1a\n" +
 	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1a\n" +
-	"\brarities\x18\x03 \x03(\tR\brarities\"\xbc\x01\n" +
+	"\brarities\x18\x03 \x03(\tR\brarities\x12$\n" +
+	"\rfirstPrinting\x18\x04 \x01(\bR\rfirstPrinting\x126\n" +
+	"\x16firstPrintingProductID\x18\x05 \x01(\tR\x16firstPrintingProductID\x12,\n" +
+	"\x11firstPrintingDate\x18\x06 \x01(\tR\x11firstPrintingDate\"\xbc\x01\n" +
 	"\x0eProductSummary\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x16\n" +
 	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x12\n" +
@@ -1057,14 +4535,71 @@ const file_ygo_service_proto_rawDesc = "" +
 	"\x11unknown_resources\x18\x02 \x03(\tR\x10unknownResources\x1aP\n" +
 	"\rProductsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.ygo.ProductSummaryR\x05value:\x028\x01\"\x1e\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.ygo.ProductSummaryR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x14ProductSearchRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.ygo.ProductSearchFilterR\x06filter\x12;\n" +
+	"\n" +
+	"sort_order\x18\x02 \x01(\x0e2\x1c.ygo.common.ProductSortOrderR\tsortOrder\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\xc6\x01\n" +
+	"\x13ProductSearchFilter\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12\x1b\n" +
+	"\tsub_types\x18\x02 \x03(\tR\bsubTypes\x12\x18\n" +
+	"\alocales\x18\x03 \x03(\tR\alocales\x12%\n" +
+	"\x0ereleased_after\x18\x04 \x01(\tR\rreleasedAfter\x12'\n" +
+	"\x0freleased_before\x18\x05 \x01(\tR\x0ereleasedBefore\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\"h\n" +
+	"\x14ProductSearchResults\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.ygo.ProductSummaryR\bproducts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xd8\x01\n" +
+	"\fPrintedCards\x12H\n" +
+	"\rprinted_cards\x18\x01 \x03(\v2#.ygo.PrintedCards.PrintedCardsEntryR\fprintedCards\x12+\n" +
+	"\x11unknown_resources\x18\x02 \x03(\tR\x10unknownResources\x1aQ\n" +
+	"\x11PrintedCardsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.ygo.PrintedCardR\x05value:\x028\x01\"\x93\x01\n" +
+	"\vPrintedCard\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.ygo.ProductSummaryR\aproduct\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\tR\bposition\x12\x1a\n" +
+	"\brarities\x18\x04 \x03(\tR\brarities\"_\n" +
+	"\rCardPrintings\x12\x1d\n" +
+	"\x04card\x18\x01 \x01(\v2\t.ygo.CardR\x04card\x12/\n" +
+	"\tprintings\x18\x02 \x03(\v2\x11.ygo.CardPrintingR\tprintings\"u\n" +
+	"\fCardPrinting\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.ygo.ProductSummaryR\aproduct\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1a\n" +
+	"\brarities\x18\x03 \x03(\tR\brarities\"\xd6\x01\n" +
+	"\x12BatchCardPrintings\x12B\n" +
+	"\tcard_info\x18\x01 \x03(\v2%.ygo.BatchCardPrintings.CardInfoEntryR\bcardInfo\x12+\n" +
+	"\x11unknown_resources\x18\x02 \x03(\tR\x10unknownResources\x1aO\n" +
+	"\rCardInfoEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.ygo.CardPrintingsR\x05value:\x028\x01\"\x1e\n" +
 	"\x06Format\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"\x9e\x01\n" +
 	"\x18RestrictedContentRequest\x12\x16\n" +
 	"\x06format\x18\x01 \x01(\tR\x06format\x12%\n" +
 	"\x0eeffective_date\x18\x02 \x01(\tR\reffectiveDate\x12C\n" +
 	"\n" +
-	"sort_order\x18\x03 \x01(\x0e2$.ygo.common.CardRestrictionSortOrderR\tsortOrder\"\xc3\x02\n" +
+	"sort_order\x18\x03 \x01(\x0e2$.ygo.common.CardRestrictionSortOrderR\tsortOrder\"=\n" +
+	"\x0eCatalogEntries\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.ygo.CatalogEntryR\aentries\"u\n" +
+	"\fCatalogEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12#\n" +
+	"\rdisplay_order\x18\x03 \x01(\rR\fdisplayOrder\x12\x16\n" +
+	"\x06parent\x18\x04 \x01(\tR\x06parent\"?\n" +
+	"\x10CardColorCatalog\x12+\n" +
+	"\x06colors\x18\x01 \x03(\v2\x13.ygo.CardColorEntryR\x06colors\"\xe4\x01\n" +
+	"\x0eCardColorEntry\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\rR\x02ID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count\x12#\n" +
+	"\rdisplay_order\x18\x04 \x01(\rR\fdisplayOrder\x12=\n" +
+	"\rdeck_location\x18\x05 \x01(\x0e2\x18.ygo.common.DeckLocationR\fdeckLocation\x124\n" +
+	"\bcategory\x18\x06 \x01(\x0e2\x18.ygo.common.CardCategoryR\bcategory\"\xc3\x02\n" +
 	"\x16ScoresForFormatAndDate\x12\x16\n" +
 	"\x06format\x18\x01 \x01(\tR\x06format\x12%\n" +
 	"\x0eeffective_date\x18\x02 \x01(\tR\reffectiveDate\x12F\n" +
@@ -1094,25 +4629,52 @@ const file_ygo_service_proto_rawDesc = "" +
 	"ScoreEntry\x12\x16\n" +
 	"\x06format\x18\x01 \x01(\tR\x06format\x12%\n" +
 	"\x0eeffective_date\x18\x02 \x01(\tR\reffectiveDate\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\rR\x05score2\xc4\x04\n" +
+	"\x05score\x18\x03 \x01(\rR\x05score2\x8f\v\n" +
 	"\vCardService\x128\n" +
 	"\rGetCardColors\x12\x16.google.protobuf.Empty\x1a\x0f.ygo.CardColors\x120\n" +
 	"\vGetCardByID\x12\x16.ygo.common.ResourceID\x1a\t.ygo.Card\x123\n" +
 	"\fGetCardsByID\x12\x17.ygo.common.ResourceIDs\x1a\n" +
-	".ygo.Cards\x127\n" +
+	".ygo.Cards\x12=\n" +
+	"\vSearchCards\x12\x16.ygo.CardSearchRequest\x1a\x16.ygo.CardSearchResults\x12H\n" +
+	"\x0eSearchCardText\x12\x1a.ygo.CardTextSearchRequest\x1a\x1a.ygo.CardTextSearchResults\x129\n" +
+	"\x0eStreamAllCards\x12\x16.ygo.CardStreamRequest\x1a\r.ygo.CardList0\x01\x127\n" +
 	"\x0eGetCardsByName\x12\x19.ygo.common.ResourceNames\x1a\n" +
-	".ygo.Cards\x12K\n" +
-	"\x1fGetCardsReferencingNameInEffect\x12\x19.ygo.common.ResourceNames\x1a\r.ygo.CardList\x12G\n" +
+	".ygo.Cards\x12R\n" +
+	"\x16GetCardNameSuggestions\x12\x1e.ygo.CardNameSuggestionRequest\x1a\x18.ygo.CardNameSuggestions\x12K\n" +
+	"\x1fGetCardsReferencingNameInEffect\x12\x19.ygo.common.ResourceNames\x1a\r.ygo.CardList\x12P\n" +
+	"!GetExtraDeckMonstersUsingMaterial\x12\x16.ygo.common.ResourceID\x1a\x13.ygo.MaterialUsages\x12J\n" +
+	"\x16GetCardEffectBreakdown\x12\x16.ygo.common.ResourceID\x1a\x18.ygo.CardEffectBreakdown\x12P\n" +
+	"\x15GetCardReferenceGraph\x12\x1e.ygo.CardReferenceGraphRequest\x1a\x17.ygo.CardReferenceGraph\x12>\n" +
+	"\x0fGetSimilarCards\x12\x18.ygo.SimilarCardsRequest\x1a\x11.ygo.SimilarCards\x12G\n" +
 	"\x1fGetArchetypalCardsUsingCardName\x12\x15.ygo.common.Archetype\x1a\r.ygo.CardList\x12G\n" +
 	"\x1fGetExplicitArchetypalInclusions\x12\x15.ygo.common.Archetype\x1a\r.ygo.CardList\x12G\n" +
-	"\x1fGetExplicitArchetypalExclusions\x12\x15.ygo.common.Archetype\x1a\r.ygo.CardList\x123\n" +
-	"\rGetRandomCard\x12\x17.ygo.common.BlackListed\x1a\t.ygo.Card2\xd5\x01\n" +
+	"\x1fGetExplicitArchetypalExclusions\x12\x15.ygo.common.Archetype\x1a\r.ygo.CardList\x12C\n" +
+	"\x13GetArchetypeMembers\x12\x15.ygo.common.Archetype\x1a\x15.ygo.ArchetypeMembers\x12?\n" +
+	"\x0eListArchetypes\x12\x16.google.protobuf.Empty\x1a\x15.ygo.ArchetypeCatalog\x123\n" +
+	"\rGetRandomCard\x12\x17.ygo.common.BlackListed\x1a\t.ygo.Card\x12;\n" +
+	"\x0eGetRandomCards\x12\x17.ygo.common.BlackListed\x1a\x10.ygo.RandomCards\x12>\n" +
+	"\x0fGetCardOfTheDay\x12\x18.ygo.CardOfTheDayRequest\x1a\x11.ygo.CardOfTheDay2\xb1\x04\n" +
 	"\x0eProductService\x12;\n" +
 	"\x13GetCardsByProductID\x12\x16.ygo.common.ResourceID\x1a\f.ygo.Product\x12D\n" +
 	"\x15GetProductSummaryByID\x12\x16.ygo.common.ResourceID\x1a\x13.ygo.ProductSummary\x12@\n" +
-	"\x16GetProductsSummaryByID\x12\x17.ygo.common.ResourceIDs\x1a\r.ygo.Products2e\n" +
+	"\x16GetProductsSummaryByID\x12\x17.ygo.common.ResourceIDs\x1a\r.ygo.Products\x12D\n" +
+	"\x18GetProductsSummaryByName\x12\x19.ygo.common.ResourceNames\x1a\r.ygo.Products\x12F\n" +
+	"\x0eSearchProducts\x12\x19.ygo.ProductSearchRequest\x1a\x19.ygo.ProductSearchResults\x12A\n" +
+	"\x13GetCardsByPrintCode\x12\x17.ygo.common.ResourceIDs\x1a\x11.ygo.PrintedCards\x12>\n" +
+	"\x10GetCardPrintings\x12\x16.ygo.common.ResourceID\x1a\x12.ygo.CardPrintings\x12I\n" +
+	"\x15GetCardPrintingsByIDs\x12\x17.ygo.common.ResourceIDs\x1a\x17.ygo.BatchCardPrintings2e\n" +
 	"\x16CardRestrictionService\x12K\n" +
-	"\x1dGetEffectiveTimelineForFormat\x12\v.ygo.Format\x1a\x1d.ygo.common.EffectiveTimeline2\xe2\x01\n" +
+	"\x1dGetEffectiveTimelineForFormat\x12\v.ygo.Format\x1a\x1d.ygo.common.EffectiveTimeline2\x96\x04\n" +
+	"\x0eCatalogService\x12D\n" +
+	"\x13GetCardColorCatalog\x12\x16.google.protobuf.Empty\x1a\x15.ygo.CardColorCatalog\x12@\n" +
+	"\x11GetCardAttributes\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x12>\n" +
+	"\x0fGetMonsterTypes\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x12B\n" +
+	"\x13GetMonsterAbilities\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x12>\n" +
+	"\x0fGetProductTypes\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x12A\n" +
+	"\x12GetProductSubTypes\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x12:\n" +
+	"\vGetRarities\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries\x129\n" +
+	"\n" +
+	"GetLocales\x12\x16.google.protobuf.Empty\x1a\x13.ygo.CatalogEntries2\xe2\x01\n" +
 	"\fScoreService\x12V\n" +
 	"\x18GetScoresByFormatAndDate\x12\x1d.ygo.RestrictedContentRequest\x1a\x1b.ygo.ScoresForFormatAndDate\x12:\n" +
 	"\x10GetCardScoreByID\x12\x16.ygo.common.ResourceID\x1a\x0e.ygo.CardScore\x12>\n" +
@@ -1130,99 +4692,300 @@ func file_ygo_service_proto_rawDescGZIP() []byte {
 	return file_ygo_service_proto_rawDescData
 }
 
-var file_ygo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_ygo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_ygo_service_proto_goTypes = []any{
-	(*CardColors)(nil),               // 0: ygo.CardColors
-	(*Card)(nil),                     // 1: ygo.Card
-	(*Cards)(nil),                    // 2: ygo.Cards
-	(*CardList)(nil),                 // 3: ygo.CardList
-	(*Product)(nil),                  // 4: ygo.Product
-	(*ProductItem)(nil),              // 5: ygo.ProductItem
-	(*ProductSummary)(nil),           // 6: ygo.ProductSummary
-	(*Products)(nil),                 // 7: ygo.Products
-	(*Format)(nil),                   // 8: ygo.Format
-	(*RestrictedContentRequest)(nil), // 9: ygo.RestrictedContentRequest
-	(*ScoresForFormatAndDate)(nil),   // 10: ygo.ScoresForFormatAndDate
-	(*CardScoreEntry)(nil),           // 11: ygo.CardScoreEntry
-	(*CardScore)(nil),                // 12: ygo.CardScore
-	(*CardScores)(nil),               // 13: ygo.CardScores
-	(*ScoreEntry)(nil),               // 14: ygo.ScoreEntry
-	nil,                              // 15: ygo.CardColors.ValuesEntry
-	nil,                              // 16: ygo.Cards.CardInfoEntry
-	nil,                              // 17: ygo.Product.RarityDistributionEntry
-	nil,                              // 18: ygo.Products.ProductsEntry
-	nil,                              // 19: ygo.CardScore.CurrentScoreByFormatEntry
-	nil,                              // 20: ygo.CardScores.CardInfoEntry
-	(*wrapperspb.StringValue)(nil),   // 21: google.protobuf.StringValue
-	(*wrapperspb.UInt32Value)(nil),   // 22: google.protobuf.UInt32Value
-	(CardRestrictionSortOrder)(0),    // 23: ygo.common.CardRestrictionSortOrder
-	(*emptypb.Empty)(nil),            // 24: google.protobuf.Empty
-	(*ResourceID)(nil),               // 25: ygo.common.ResourceID
-	(*ResourceIDs)(nil),              // 26: ygo.common.ResourceIDs
-	(*ResourceNames)(nil),            // 27: ygo.common.ResourceNames
-	(*Archetype)(nil),                // 28: ygo.common.Archetype
-	(*BlackListed)(nil),              // 29: ygo.common.BlackListed
-	(*EffectiveTimeline)(nil),        // 30: ygo.common.EffectiveTimeline
+	(*CardColors)(nil),                // 0: ygo.CardColors
+	(*Card)(nil),                      // 1: ygo.Card
+	(*SimilarCardsRequest)(nil),       // 2: ygo.SimilarCardsRequest
+	(*SimilarCards)(nil),              // 3: ygo.SimilarCards
+	(*SimilarCard)(nil),               // 4: ygo.SimilarCard
+	(*CardEffectBreakdown)(nil),       // 5: ygo.CardEffectBreakdown
+	(*EffectBreakdown)(nil),           // 6: ygo.EffectBreakdown
+	(*EffectClause)(nil),              // 7: ygo.EffectClause
+	(*EffectTagMatch)(nil),            // 8: ygo.EffectTagMatch
+	(*CardClassification)(nil),        // 9: ygo.CardClassification
+	(*MonsterType)(nil),               // 10: ygo.MonsterType
+	(*Materials)(nil),                 // 11: ygo.Materials
+	(*MaterialRequirement)(nil),       // 12: ygo.MaterialRequirement
+	(*MaterialUsages)(nil),            // 13: ygo.MaterialUsages
+	(*Cards)(nil),                     // 14: ygo.Cards
+	(*CardList)(nil),                  // 15: ygo.CardList
+	(*TextSpan)(nil),                  // 16: ygo.TextSpan
+	(*TextSpans)(nil),                 // 17: ygo.TextSpans
+	(*RandomCards)(nil),               // 18: ygo.RandomCards
+	(*CardOfTheDayRequest)(nil),       // 19: ygo.CardOfTheDayRequest
+	(*CardOfTheDay)(nil),              // 20: ygo.CardOfTheDay
+	(*CardSearchRequest)(nil),         // 21: ygo.CardSearchRequest
+	(*CardSearchFilter)(nil),          // 22: ygo.CardSearchFilter
+	(*MonsterTypeFilter)(nil),         // 23: ygo.MonsterTypeFilter
+	(*StatRange)(nil),                 // 24: ygo.StatRange
+	(*CardSearchResults)(nil),         // 25: ygo.CardSearchResults
+	(*CardTextSearchRequest)(nil),     // 26: ygo.CardTextSearchRequest
+	(*CardTextSearchResults)(nil),     // 27: ygo.CardTextSearchResults
+	(*CardTextMatch)(nil),             // 28: ygo.CardTextMatch
+	(*CardStreamRequest)(nil),         // 29: ygo.CardStreamRequest
+	(*CardReferenceGraphRequest)(nil), // 30: ygo.CardReferenceGraphRequest
+	(*CardReferenceGraph)(nil),        // 31: ygo.CardReferenceGraph
+	(*CardReferenceEdge)(nil),         // 32: ygo.CardReferenceEdge
+	(*ArchetypeMembers)(nil),          // 33: ygo.ArchetypeMembers
+	(*ArchetypeMember)(nil),           // 34: ygo.ArchetypeMember
+	(*ArchetypeCatalog)(nil),          // 35: ygo.ArchetypeCatalog
+	(*ArchetypeSummary)(nil),          // 36: ygo.ArchetypeSummary
+	(*CardNameSuggestionRequest)(nil), // 37: ygo.CardNameSuggestionRequest
+	(*CardNameSuggestion)(nil),        // 38: ygo.CardNameSuggestion
+	(*CardNameSuggestions)(nil),       // 39: ygo.CardNameSuggestions
+	(*Product)(nil),                   // 40: ygo.Product
+	(*ProductItem)(nil),               // 41: ygo.ProductItem
+	(*ProductSummary)(nil),            // 42: ygo.ProductSummary
+	(*Products)(nil),                  // 43: ygo.Products
+	(*ProductSearchRequest)(nil),      // 44: ygo.ProductSearchRequest
+	(*ProductSearchFilter)(nil),       // 45: ygo.ProductSearchFilter
+	(*ProductSearchResults)(nil),      // 46: ygo.ProductSearchResults
+	(*PrintedCards)(nil),              // 47: ygo.PrintedCards
+	(*PrintedCard)(nil),               // 48: ygo.PrintedCard
+	(*CardPrintings)(nil),             // 49: ygo.CardPrintings
+	(*CardPrinting)(nil),              // 50: ygo.CardPrinting
+	(*BatchCardPrintings)(nil),        // 51: ygo.BatchCardPrintings
+	(*Format)(nil),                    // 52: ygo.Format
+	(*RestrictedContentRequest)(nil),  // 53: ygo.RestrictedContentRequest
+	(*CatalogEntries)(nil),            // 54: ygo.CatalogEntries
+	(*CatalogEntry)(nil),              // 55: ygo.CatalogEntry
+	(*CardColorCatalog)(nil),          // 56: ygo.CardColorCatalog
+	(*CardColorEntry)(nil),            // 57: ygo.CardColorEntry
+	(*ScoresForFormatAndDate)(nil),    // 58: ygo.ScoresForFormatAndDate
+	(*CardScoreEntry)(nil),            // 59: ygo.CardScoreEntry
+	(*CardScore)(nil),                 // 60: ygo.CardScore
+	(*CardScores)(nil),                // 61: ygo.CardScores
+	(*ScoreEntry)(nil),                // 62: ygo.ScoreEntry
+	nil,                               // 63: ygo.CardColors.ValuesEntry
+	nil,                               // 64: ygo.Cards.CardInfoEntry
+	nil,                               // 65: ygo.Cards.SuggestionsEntry
+	nil,                               // 66: ygo.CardList.HighlightsEntry
+	nil,                               // 67: ygo.CardReferenceGraph.CardsEntry
+	nil,                               // 68: ygo.Product.RarityDistributionEntry
+	nil,                               // 69: ygo.Products.ProductsEntry
+	nil,                               // 70: ygo.PrintedCards.PrintedCardsEntry
+	nil,                               // 71: ygo.BatchCardPrintings.CardInfoEntry
+	nil,                               // 72: ygo.CardScore.CurrentScoreByFormatEntry
+	nil,                               // 73: ygo.CardScores.CardInfoEntry
+	(*wrapperspb.StringValue)(nil),    // 74: google.protobuf.StringValue
+	(*wrapperspb.UInt32Value)(nil),    // 75: google.protobuf.UInt32Value
+	(OncePerTurn)(0),                  // 76: ygo.common.OncePerTurn
+	(EffectTag)(0),                    // 77: ygo.common.EffectTag
+	(CardFrame)(0),                    // 78: ygo.common.CardFrame
+	(DeckLocation)(0),                 // 79: ygo.common.DeckLocation
+	(CardCategory)(0),                 // 80: ygo.common.CardCategory
+	(TunerRequirement)(0),             // 81: ygo.common.TunerRequirement
+	(CardSortOrder)(0),                // 82: ygo.common.CardSortOrder
+	(*wrapperspb.BoolValue)(nil),      // 83: google.protobuf.BoolValue
+	(CardReferenceType)(0),            // 84: ygo.common.CardReferenceType
+	(ArchetypeInclusionReason)(0),     // 85: ygo.common.ArchetypeInclusionReason
+	(ProductSortOrder)(0),             // 86: ygo.common.ProductSortOrder
+	(CardRestrictionSortOrder)(0),     // 87: ygo.common.CardRestrictionSortOrder
+	(*emptypb.Empty)(nil),             // 88: google.protobuf.Empty
+	(*ResourceID)(nil),                // 89: ygo.common.ResourceID
+	(*ResourceIDs)(nil),               // 90: ygo.common.ResourceIDs
+	(*ResourceNames)(nil),             // 91: ygo.common.ResourceNames
+	(*Archetype)(nil),                 // 92: ygo.common.Archetype
+	(*BlackListed)(nil),               // 93: ygo.common.BlackListed
+	(*EffectiveTimeline)(nil),         // 94: ygo.common.EffectiveTimeline
 }
 var file_ygo_service_proto_depIdxs = []int32{
-	15, // 0: ygo.CardColors.values:type_name -> ygo.CardColors.ValuesEntry
-	21, // 1: ygo.Card.monster_type:type_name -> google.protobuf.StringValue
-	22, // 2: ygo.Card.attack:type_name -> google.protobuf.UInt32Value
-	22, // 3: ygo.Card.defense:type_name -> google.protobuf.UInt32Value
-	16, // 4: ygo.Cards.card_info:type_name -> ygo.Cards.CardInfoEntry
-	1,  // 5: ygo.CardList.cards:type_name -> ygo.Card
-	5,  // 6: ygo.Product.items:type_name -> ygo.ProductItem
-	17, // 7: ygo.Product.rarityDistribution:type_name -> ygo.Product.RarityDistributionEntry
-	1,  // 8: ygo.ProductItem.card:type_name -> ygo.Card
-	18, // 9: ygo.Products.products:type_name -> ygo.Products.ProductsEntry
-	23, // 10: ygo.RestrictedContentRequest.sort_order:type_name -> ygo.common.CardRestrictionSortOrder
-	21, // 11: ygo.ScoresForFormatAndDate.next_format_date:type_name -> google.protobuf.StringValue
-	21, // 12: ygo.ScoresForFormatAndDate.previous_format_date:type_name -> google.protobuf.StringValue
-	11, // 13: ygo.ScoresForFormatAndDate.entries:type_name -> ygo.CardScoreEntry
-	1,  // 14: ygo.CardScoreEntry.card:type_name -> ygo.Card
-	19, // 15: ygo.CardScore.current_score_by_format:type_name -> ygo.CardScore.CurrentScoreByFormatEntry
-	14, // 16: ygo.CardScore.score_history:type_name -> ygo.ScoreEntry
-	20, // 17: ygo.CardScores.card_info:type_name -> ygo.CardScores.CardInfoEntry
-	1,  // 18: ygo.Cards.CardInfoEntry.value:type_name -> ygo.Card
-	6,  // 19: ygo.Products.ProductsEntry.value:type_name -> ygo.ProductSummary
-	12, // 20: ygo.CardScores.CardInfoEntry.value:type_name -> ygo.CardScore
-	24, // 21: ygo.CardService.GetCardColors:input_type -> google.protobuf.Empty
-	25, // 22: ygo.CardService.GetCardByID:input_type -> ygo.common.ResourceID
-	26, // 23: ygo.CardService.GetCardsByID:input_type -> ygo.common.ResourceIDs
-	27, // 24: ygo.CardService.GetCardsByName:input_type -> ygo.common.ResourceNames
-	27, // 25: ygo.CardService.GetCardsReferencingNameInEffect:input_type -> ygo.common.ResourceNames
-	28, // 26: ygo.CardService.GetArchetypalCardsUsingCardName:input_type -> ygo.common.Archetype
-	28, // 27: ygo.CardService.GetExplicitArchetypalInclusions:input_type -> ygo.common.Archetype
-	28, // 28: ygo.CardService.GetExplicitArchetypalExclusions:input_type -> ygo.common.Archetype
-	29, // 29: ygo.CardService.GetRandomCard:input_type -> ygo.common.BlackListed
-	25, // 30: ygo.ProductService.GetCardsByProductID:input_type -> ygo.common.ResourceID
-	25, // 31: ygo.ProductService.GetProductSummaryByID:input_type -> ygo.common.ResourceID
-	26, // 32: ygo.ProductService.GetProductsSummaryByID:input_type -> ygo.common.ResourceIDs
-	8,  // 33: ygo.CardRestrictionService.GetEffectiveTimelineForFormat:input_type -> ygo.Format
-	9,  // 34: ygo.ScoreService.GetScoresByFormatAndDate:input_type -> ygo.RestrictedContentRequest
-	25, // 35: ygo.ScoreService.GetCardScoreByID:input_type -> ygo.common.ResourceID
-	26, // 36: ygo.ScoreService.GetCardScoresByIDs:input_type -> ygo.common.ResourceIDs
-	0,  // 37: ygo.CardService.GetCardColors:output_type -> ygo.CardColors
-	1,  // 38: ygo.CardService.GetCardByID:output_type -> ygo.Card
-	2,  // 39: ygo.CardService.GetCardsByID:output_type -> ygo.Cards
-	2,  // 40: ygo.CardService.GetCardsByName:output_type -> ygo.Cards
-	3,  // 41: ygo.CardService.GetCardsReferencingNameInEffect:output_type -> ygo.CardList
-	3,  // 42: ygo.CardService.GetArchetypalCardsUsingCardName:output_type -> ygo.CardList
-	3,  // 43: ygo.CardService.GetExplicitArchetypalInclusions:output_type -> ygo.CardList
-	3,  // 44: ygo.CardService.GetExplicitArchetypalExclusions:output_type -> ygo.CardList
-	1,  // 45: ygo.CardService.GetRandomCard:output_type -> ygo.Card
-	4,  // 46: ygo.ProductService.GetCardsByProductID:output_type -> ygo.Product
-	6,  // 47: ygo.ProductService.GetProductSummaryByID:output_type -> ygo.ProductSummary
-	7,  // 48: ygo.ProductService.GetProductsSummaryByID:output_type -> ygo.Products
-	30, // 49: ygo.CardRestrictionService.GetEffectiveTimelineForFormat:output_type -> ygo.common.EffectiveTimeline
-	10, // 50: ygo.ScoreService.GetScoresByFormatAndDate:output_type -> ygo.ScoresForFormatAndDate
-	12, // 51: ygo.ScoreService.GetCardScoreByID:output_type -> ygo.CardScore
-	13, // 52: ygo.ScoreService.GetCardScoresByIDs:output_type -> ygo.CardScores
-	37, // [37:53] is the sub-list for method output_type
-	21, // [21:37] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	63,  // 0: ygo.CardColors.values:type_name -> ygo.CardColors.ValuesEntry
+	74,  // 1: ygo.Card.monster_type:type_name -> google.protobuf.StringValue
+	75,  // 2: ygo.Card.attack:type_name -> google.protobuf.UInt32Value
+	75,  // 3: ygo.Card.defense:type_name -> google.protobuf.UInt32Value
+	11,  // 4: ygo.Card.materials:type_name -> ygo.Materials
+	75,  // 5: ygo.Card.pendulum_scale:type_name -> google.protobuf.UInt32Value
+	74,  // 6: ygo.Card.pendulum_effect:type_name -> google.protobuf.StringValue
+	74,  // 7: ygo.Card.monster_effect:type_name -> google.protobuf.StringValue
+	10,  // 8: ygo.Card.monster_type_details:type_name -> ygo.MonsterType
+	9,   // 9: ygo.Card.classification:type_name -> ygo.CardClassification
+	8,   // 10: ygo.Card.tags:type_name -> ygo.EffectTagMatch
+	1,   // 11: ygo.SimilarCards.card:type_name -> ygo.Card
+	4,   // 12: ygo.SimilarCards.similar:type_name -> ygo.SimilarCard
+	1,   // 13: ygo.SimilarCard.card:type_name -> ygo.Card
+	1,   // 14: ygo.CardEffectBreakdown.card:type_name -> ygo.Card
+	6,   // 15: ygo.CardEffectBreakdown.effect:type_name -> ygo.EffectBreakdown
+	6,   // 16: ygo.CardEffectBreakdown.pendulum_effect:type_name -> ygo.EffectBreakdown
+	7,   // 17: ygo.EffectBreakdown.effects:type_name -> ygo.EffectClause
+	76,  // 18: ygo.EffectClause.once_per_turn:type_name -> ygo.common.OncePerTurn
+	77,  // 19: ygo.EffectTagMatch.tag:type_name -> ygo.common.EffectTag
+	16,  // 20: ygo.EffectTagMatch.spans:type_name -> ygo.TextSpan
+	78,  // 21: ygo.CardClassification.frame:type_name -> ygo.common.CardFrame
+	79,  // 22: ygo.CardClassification.deck_location:type_name -> ygo.common.DeckLocation
+	80,  // 23: ygo.CardClassification.category:type_name -> ygo.common.CardCategory
+	75,  // 24: ygo.Materials.max_count:type_name -> google.protobuf.UInt32Value
+	12,  // 25: ygo.Materials.requirements:type_name -> ygo.MaterialRequirement
+	81,  // 26: ygo.MaterialRequirement.tuner:type_name -> ygo.common.TunerRequirement
+	75,  // 27: ygo.MaterialRequirement.min_level:type_name -> google.protobuf.UInt32Value
+	75,  // 28: ygo.MaterialRequirement.max_level:type_name -> google.protobuf.UInt32Value
+	1,   // 29: ygo.MaterialUsages.material:type_name -> ygo.Card
+	1,   // 30: ygo.MaterialUsages.named_explicitly:type_name -> ygo.Card
+	1,   // 31: ygo.MaterialUsages.matches_generically:type_name -> ygo.Card
+	64,  // 32: ygo.Cards.card_info:type_name -> ygo.Cards.CardInfoEntry
+	65,  // 33: ygo.Cards.suggestions:type_name -> ygo.Cards.SuggestionsEntry
+	1,   // 34: ygo.CardList.cards:type_name -> ygo.Card
+	66,  // 35: ygo.CardList.highlights:type_name -> ygo.CardList.HighlightsEntry
+	16,  // 36: ygo.TextSpans.spans:type_name -> ygo.TextSpan
+	1,   // 37: ygo.RandomCards.cards:type_name -> ygo.Card
+	1,   // 38: ygo.CardOfTheDay.card:type_name -> ygo.Card
+	22,  // 39: ygo.CardSearchRequest.filter:type_name -> ygo.CardSearchFilter
+	82,  // 40: ygo.CardSearchRequest.sort_order:type_name -> ygo.common.CardSortOrder
+	24,  // 41: ygo.CardSearchFilter.attack:type_name -> ygo.StatRange
+	24,  // 42: ygo.CardSearchFilter.defense:type_name -> ygo.StatRange
+	23,  // 43: ygo.CardSearchFilter.monster_type:type_name -> ygo.MonsterTypeFilter
+	77,  // 44: ygo.CardSearchFilter.tags:type_name -> ygo.common.EffectTag
+	83,  // 45: ygo.MonsterTypeFilter.tuner:type_name -> google.protobuf.BoolValue
+	83,  // 46: ygo.MonsterTypeFilter.flip:type_name -> google.protobuf.BoolValue
+	83,  // 47: ygo.MonsterTypeFilter.gemini:type_name -> google.protobuf.BoolValue
+	83,  // 48: ygo.MonsterTypeFilter.spirit:type_name -> google.protobuf.BoolValue
+	83,  // 49: ygo.MonsterTypeFilter.union:type_name -> google.protobuf.BoolValue
+	83,  // 50: ygo.MonsterTypeFilter.toon:type_name -> google.protobuf.BoolValue
+	83,  // 51: ygo.MonsterTypeFilter.normal:type_name -> google.protobuf.BoolValue
+	83,  // 52: ygo.MonsterTypeFilter.effect:type_name -> google.protobuf.BoolValue
+	83,  // 53: ygo.MonsterTypeFilter.ritual:type_name -> google.protobuf.BoolValue
+	83,  // 54: ygo.MonsterTypeFilter.fusion:type_name -> google.protobuf.BoolValue
+	83,  // 55: ygo.MonsterTypeFilter.synchro:type_name -> google.protobuf.BoolValue
+	83,  // 56: ygo.MonsterTypeFilter.xyz:type_name -> google.protobuf.BoolValue
+	83,  // 57: ygo.MonsterTypeFilter.pendulum:type_name -> google.protobuf.BoolValue
+	83,  // 58: ygo.MonsterTypeFilter.link:type_name -> google.protobuf.BoolValue
+	75,  // 59: ygo.StatRange.min:type_name -> google.protobuf.UInt32Value
+	75,  // 60: ygo.StatRange.max:type_name -> google.protobuf.UInt32Value
+	1,   // 61: ygo.CardSearchResults.cards:type_name -> ygo.Card
+	28,  // 62: ygo.CardTextSearchResults.matches:type_name -> ygo.CardTextMatch
+	1,   // 63: ygo.CardTextMatch.card:type_name -> ygo.Card
+	16,  // 64: ygo.CardTextMatch.highlights:type_name -> ygo.TextSpan
+	67,  // 65: ygo.CardReferenceGraph.cards:type_name -> ygo.CardReferenceGraph.CardsEntry
+	32,  // 66: ygo.CardReferenceGraph.edges:type_name -> ygo.CardReferenceEdge
+	84,  // 67: ygo.CardReferenceEdge.type:type_name -> ygo.common.CardReferenceType
+	34,  // 68: ygo.ArchetypeMembers.members:type_name -> ygo.ArchetypeMember
+	1,   // 69: ygo.ArchetypeMembers.excluded:type_name -> ygo.Card
+	1,   // 70: ygo.ArchetypeMember.card:type_name -> ygo.Card
+	85,  // 71: ygo.ArchetypeMember.reason:type_name -> ygo.common.ArchetypeInclusionReason
+	36,  // 72: ygo.ArchetypeCatalog.archetypes:type_name -> ygo.ArchetypeSummary
+	38,  // 73: ygo.CardNameSuggestions.suggestions:type_name -> ygo.CardNameSuggestion
+	41,  // 74: ygo.Product.items:type_name -> ygo.ProductItem
+	68,  // 75: ygo.Product.rarityDistribution:type_name -> ygo.Product.RarityDistributionEntry
+	1,   // 76: ygo.ProductItem.card:type_name -> ygo.Card
+	69,  // 77: ygo.Products.products:type_name -> ygo.Products.ProductsEntry
+	45,  // 78: ygo.ProductSearchRequest.filter:type_name -> ygo.ProductSearchFilter
+	86,  // 79: ygo.ProductSearchRequest.sort_order:type_name -> ygo.common.ProductSortOrder
+	42,  // 80: ygo.ProductSearchResults.products:type_name -> ygo.ProductSummary
+	70,  // 81: ygo.PrintedCards.printed_cards:type_name -> ygo.PrintedCards.PrintedCardsEntry
+	1,   // 82: ygo.PrintedCard.card:type_name -> ygo.Card
+	42,  // 83: ygo.PrintedCard.product:type_name -> ygo.ProductSummary
+	1,   // 84: ygo.CardPrintings.card:type_name -> ygo.Card
+	50,  // 85: ygo.CardPrintings.printings:type_name -> ygo.CardPrinting
+	42,  // 86: ygo.CardPrinting.product:type_name -> ygo.ProductSummary
+	71,  // 87: ygo.BatchCardPrintings.card_info:type_name -> ygo.BatchCardPrintings.CardInfoEntry
+	87,  // 88: ygo.RestrictedContentRequest.sort_order:type_name -> ygo.common.CardRestrictionSortOrder
+	55,  // 89: ygo.CatalogEntries.entries:type_name -> ygo.CatalogEntry
+	57,  // 90: ygo.CardColorCatalog.colors:type_name -> ygo.CardColorEntry
+	79,  // 91: ygo.CardColorEntry.deck_location:type_name -> ygo.common.DeckLocation
+	80,  // 92: ygo.CardColorEntry.category:type_name -> ygo.common.CardCategory
+	74,  // 93: ygo.ScoresForFormatAndDate.next_format_date:type_name -> google.protobuf.StringValue
+	74,  // 94: ygo.ScoresForFormatAndDate.previous_format_date:type_name -> google.protobuf.StringValue
+	59,  // 95: ygo.ScoresForFormatAndDate.entries:type_name -> ygo.CardScoreEntry
+	1,   // 96: ygo.CardScoreEntry.card:type_name -> ygo.Card
+	72,  // 97: ygo.CardScore.current_score_by_format:type_name -> ygo.CardScore.CurrentScoreByFormatEntry
+	62,  // 98: ygo.CardScore.score_history:type_name -> ygo.ScoreEntry
+	73,  // 99: ygo.CardScores.card_info:type_name -> ygo.CardScores.CardInfoEntry
+	1,   // 100: ygo.Cards.CardInfoEntry.value:type_name -> ygo.Card
+	39,  // 101: ygo.Cards.SuggestionsEntry.value:type_name -> ygo.CardNameSuggestions
+	17,  // 102: ygo.CardList.HighlightsEntry.value:type_name -> ygo.TextSpans
+	1,   // 103: ygo.CardReferenceGraph.CardsEntry.value:type_name -> ygo.Card
+	42,  // 104: ygo.Products.ProductsEntry.value:type_name -> ygo.ProductSummary
+	48,  // 105: ygo.PrintedCards.PrintedCardsEntry.value:type_name -> ygo.PrintedCard
+	49,  // 106: ygo.BatchCardPrintings.CardInfoEntry.value:type_name -> ygo.CardPrintings
+	60,  // 107: ygo.CardScores.CardInfoEntry.value:type_name -> ygo.CardScore
+	88,  // 108: ygo.CardService.GetCardColors:input_type -> google.protobuf.Empty
+	89,  // 109: ygo.CardService.GetCardByID:input_type -> ygo.common.ResourceID
+	90,  // 110: ygo.CardService.GetCardsByID:input_type -> ygo.common.ResourceIDs
+	21,  // 111: ygo.CardService.SearchCards:input_type -> ygo.CardSearchRequest
+	26,  // 112: ygo.CardService.SearchCardText:input_type -> ygo.CardTextSearchRequest
+	29,  // 113: ygo.CardService.StreamAllCards:input_type -> ygo.CardStreamRequest
+	91,  // 114: ygo.CardService.GetCardsByName:input_type -> ygo.common.ResourceNames
+	37,  // 115: ygo.CardService.GetCardNameSuggestions:input_type -> ygo.CardNameSuggestionRequest
+	91,  // 116: ygo.CardService.GetCardsReferencingNameInEffect:input_type -> ygo.common.ResourceNames
+	89,  // 117: ygo.CardService.GetExtraDeckMonstersUsingMaterial:input_type -> ygo.common.ResourceID
+	89,  // 118: ygo.CardService.GetCardEffectBreakdown:input_type -> ygo.common.ResourceID
+	30,  // 119: ygo.CardService.GetCardReferenceGraph:input_type -> ygo.CardReferenceGraphRequest
+	2,   // 120: ygo.CardService.GetSimilarCards:input_type -> ygo.SimilarCardsRequest
+	92,  // 121: ygo.CardService.GetArchetypalCardsUsingCardName:input_type -> ygo.common.Archetype
+	92,  // 122: ygo.CardService.GetExplicitArchetypalInclusions:input_type -> ygo.common.Archetype
+	92,  // 123: ygo.CardService.GetExplicitArchetypalExclusions:input_type -> ygo.common.Archetype
+	92,  // 124: ygo.CardService.GetArchetypeMembers:input_type -> ygo.common.Archetype
+	88,  // 125: ygo.CardService.ListArchetypes:input_type -> google.protobuf.Empty
+	93,  // 126: ygo.CardService.GetRandomCard:input_type -> ygo.common.BlackListed
+	93,  // 127: ygo.CardService.GetRandomCards:input_type -> ygo.common.BlackListed
+	19,  // 128: ygo.CardService.GetCardOfTheDay:input_type -> ygo.CardOfTheDayRequest
+	89,  // 129: ygo.ProductService.GetCardsByProductID:input_type -> ygo.common.ResourceID
+	89,  // 130: ygo.ProductService.GetProductSummaryByID:input_type -> ygo.common.ResourceID
+	90,  // 131: ygo.ProductService.GetProductsSummaryByID:input_type -> ygo.common.ResourceIDs
+	91,  // 132: ygo.ProductService.GetProductsSummaryByName:input_type -> ygo.common.ResourceNames
+	44,  // 133: ygo.ProductService.SearchProducts:input_type -> ygo.ProductSearchRequest
+	90,  // 134: ygo.ProductService.GetCardsByPrintCode:input_type -> ygo.common.ResourceIDs
+	89,  // 135: ygo.ProductService.GetCardPrintings:input_type -> ygo.common.ResourceID
+	90,  // 136: ygo.ProductService.GetCardPrintingsByIDs:input_type -> ygo.common.ResourceIDs
+	52,  // 137: ygo.CardRestrictionService.GetEffectiveTimelineForFormat:input_type -> ygo.Format
+	88,  // 138: ygo.CatalogService.GetCardColorCatalog:input_type -> google.protobuf.Empty
+	88,  // 139: ygo.CatalogService.GetCardAttributes:input_type -> google.protobuf.Empty
+	88,  // 140: ygo.CatalogService.GetMonsterTypes:input_type -> google.protobuf.Empty
+	88,  // 141: ygo.CatalogService.GetMonsterAbilities:input_type -> google.protobuf.Empty
+	88,  // 142: ygo.CatalogService.GetProductTypes:input_type -> google.protobuf.Empty
+	88,  // 143: ygo.CatalogService.GetProductSubTypes:input_type -> google.protobuf.Empty
+	88,  // 144: ygo.CatalogService.GetRarities:input_type -> google.protobuf.Empty
+	88,  // 145: ygo.CatalogService.GetLocales:input_type -> google.protobuf.Empty
+	53,  // 146: ygo.ScoreService.GetScoresByFormatAndDate:input_type -> ygo.RestrictedContentRequest
+	89,  // 147: ygo.ScoreService.GetCardScoreByID:input_type -> ygo.common.ResourceID
+	90,  // 148: ygo.ScoreService.GetCardScoresByIDs:input_type -> ygo.common.ResourceIDs
+	0,   // 149: ygo.CardService.GetCardColors:output_type -> ygo.CardColors
+	1,   // 150: ygo.CardService.GetCardByID:output_type -> ygo.Card
+	14,  // 151: ygo.CardService.GetCardsByID:output_type -> ygo.Cards
+	25,  // 152: ygo.CardService.SearchCards:output_type -> ygo.CardSearchResults
+	27,  // 153: ygo.CardService.SearchCardText:output_type -> ygo.CardTextSearchResults
+	15,  // 154: ygo.CardService.StreamAllCards:output_type -> ygo.CardList
+	14,  // 155: ygo.CardService.GetCardsByName:output_type -> ygo.Cards
+	39,  // 156: ygo.CardService.GetCardNameSuggestions:output_type -> ygo.CardNameSuggestions
+	15,  // 157: ygo.CardService.GetCardsReferencingNameInEffect:output_type -> ygo.CardList
+	13,  // 158: ygo.CardService.GetExtraDeckMonstersUsingMaterial:output_type -> ygo.MaterialUsages
+	5,   // 159: ygo.CardService.GetCardEffectBreakdown:output_type -> ygo.CardEffectBreakdown
+	31,  // 160: ygo.CardService.GetCardReferenceGraph:output_type -> ygo.CardReferenceGraph
+	3,   // 161: ygo.CardService.GetSimilarCards:output_type -> ygo.SimilarCards
+	15,  // 162: ygo.CardService.GetArchetypalCardsUsingCardName:output_type -> ygo.CardList
+	15,  // 163: ygo.CardService.GetExplicitArchetypalInclusions:output_type -> ygo.CardList
+	15,  // 164: ygo.CardService.GetExplicitArchetypalExclusions:output_type -> ygo.CardList
+	33,  // 165: ygo.CardService.GetArchetypeMembers:output_type -> ygo.ArchetypeMembers
+	35,  // 166: ygo.CardService.ListArchetypes:output_type -> ygo.ArchetypeCatalog
+	1,   // 167: ygo.CardService.GetRandomCard:output_type -> ygo.Card
+	18,  // 168: ygo.CardService.GetRandomCards:output_type -> ygo.RandomCards
+	20,  // 169: ygo.CardService.GetCardOfTheDay:output_type -> ygo.CardOfTheDay
+	40,  // 170: ygo.ProductService.GetCardsByProductID:output_type -> ygo.Product
+	42,  // 171: ygo.ProductService.GetProductSummaryByID:output_type -> ygo.ProductSummary
+	43,  // 172: ygo.ProductService.GetProductsSummaryByID:output_type -> ygo.Products
+	43,  // 173: ygo.ProductService.GetProductsSummaryByName:output_type -> ygo.Products
+	46,  // 174: ygo.ProductService.SearchProducts:output_type -> ygo.ProductSearchResults
+	47,  // 175: ygo.ProductService.GetCardsByPrintCode:output_type -> ygo.PrintedCards
+	49,  // 176: ygo.ProductService.GetCardPrintings:output_type -> ygo.CardPrintings
+	51,  // 177: ygo.ProductService.GetCardPrintingsByIDs:output_type -> ygo.BatchCardPrintings
+	94,  // 178: ygo.CardRestrictionService.GetEffectiveTimelineForFormat:output_type -> ygo.common.EffectiveTimeline
+	56,  // 179: ygo.CatalogService.GetCardColorCatalog:output_type -> ygo.CardColorCatalog
+	54,  // 180: ygo.CatalogService.GetCardAttributes:output_type -> ygo.CatalogEntries
+	54,  // 181: ygo.CatalogService.GetMonsterTypes:output_type -> ygo.CatalogEntries
+	54,  // 182: ygo.CatalogService.GetMonsterAbilities:output_type -> ygo.CatalogEntries
+	54,  // 183: ygo.CatalogService.GetProductTypes:output_type -> ygo.CatalogEntries
+	54,  // 184: ygo.CatalogService.GetProductSubTypes:output_type -> ygo.CatalogEntries
+	54,  // 185: ygo.CatalogService.GetRarities:output_type -> ygo.CatalogEntries
+	54,  // 186: ygo.CatalogService.GetLocales:output_type -> ygo.CatalogEntries
+	58,  // 187: ygo.ScoreService.GetScoresByFormatAndDate:output_type -> ygo.ScoresForFormatAndDate
+	60,  // 188: ygo.ScoreService.GetCardScoreByID:output_type -> ygo.CardScore
+	61,  // 189: ygo.ScoreService.GetCardScoresByIDs:output_type -> ygo.CardScores
+	149, // [149:190] is the sub-list for method output_type
+	108, // [108:149] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_ygo_service_proto_init() }
@@ -1237,9 +5000,9 @@ func file_ygo_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ygo_service_proto_rawDesc), len(file_ygo_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   74,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   5,
 		},
 		GoTypes:           file_ygo_service_proto_goTypes,
 		DependencyIndexes: file_ygo_service_proto_depIdxs,
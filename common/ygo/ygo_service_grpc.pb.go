@@ -20,15 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CardService_GetCardColors_FullMethodName                   = "/ygo.CardService/GetCardColors"
-	CardService_GetCardByID_FullMethodName                     = "/ygo.CardService/GetCardByID"
-	CardService_GetCardsByID_FullMethodName                    = "/ygo.CardService/GetCardsByID"
-	CardService_GetCardsByName_FullMethodName                  = "/ygo.CardService/GetCardsByName"
-	CardService_GetCardsReferencingNameInEffect_FullMethodName = "/ygo.CardService/GetCardsReferencingNameInEffect"
-	CardService_GetArchetypalCardsUsingCardName_FullMethodName = "/ygo.CardService/GetArchetypalCardsUsingCardName"
-	CardService_GetExplicitArchetypalInclusions_FullMethodName = "/ygo.CardService/GetExplicitArchetypalInclusions"
-	CardService_GetExplicitArchetypalExclusions_FullMethodName = "/ygo.CardService/GetExplicitArchetypalExclusions"
-	CardService_GetRandomCard_FullMethodName                   = "/ygo.CardService/GetRandomCard"
+	CardService_GetCardColors_FullMethodName                     = "/ygo.CardService/GetCardColors"
+	CardService_GetCardByID_FullMethodName                       = "/ygo.CardService/GetCardByID"
+	CardService_GetCardsByID_FullMethodName                      = "/ygo.CardService/GetCardsByID"
+	CardService_SearchCards_FullMethodName                       = "/ygo.CardService/SearchCards"
+	CardService_SearchCardText_FullMethodName                    = "/ygo.CardService/SearchCardText"
+	CardService_StreamAllCards_FullMethodName                    = "/ygo.CardService/StreamAllCards"
+	CardService_GetCardsByName_FullMethodName                    = "/ygo.CardService/GetCardsByName"
+	CardService_GetCardNameSuggestions_FullMethodName            = "/ygo.CardService/GetCardNameSuggestions"
+	CardService_GetCardsReferencingNameInEffect_FullMethodName   = "/ygo.CardService/GetCardsReferencingNameInEffect"
+	CardService_GetExtraDeckMonstersUsingMaterial_FullMethodName = "/ygo.CardService/GetExtraDeckMonstersUsingMaterial"
+	CardService_GetCardEffectBreakdown_FullMethodName            = "/ygo.CardService/GetCardEffectBreakdown"
+	CardService_GetCardReferenceGraph_FullMethodName             = "/ygo.CardService/GetCardReferenceGraph"
+	CardService_GetSimilarCards_FullMethodName                   = "/ygo.CardService/GetSimilarCards"
+	CardService_GetArchetypalCardsUsingCardName_FullMethodName   = "/ygo.CardService/GetArchetypalCardsUsingCardName"
+	CardService_GetExplicitArchetypalInclusions_FullMethodName   = "/ygo.CardService/GetExplicitArchetypalInclusions"
+	CardService_GetExplicitArchetypalExclusions_FullMethodName   = "/ygo.CardService/GetExplicitArchetypalExclusions"
+	CardService_GetArchetypeMembers_FullMethodName               = "/ygo.CardService/GetArchetypeMembers"
+	CardService_ListArchetypes_FullMethodName                    = "/ygo.CardService/ListArchetypes"
+	CardService_GetRandomCard_FullMethodName                     = "/ygo.CardService/GetRandomCard"
+	CardService_GetRandomCards_FullMethodName                    = "/ygo.CardService/GetRandomCards"
+	CardService_GetCardOfTheDay_FullMethodName                   = "/ygo.CardService/GetCardOfTheDay"
 )
 
 // CardServiceClient is the client API for CardService service.
@@ -38,12 +50,24 @@ type CardServiceClient interface {
 	GetCardColors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CardColors, error)
 	GetCardByID(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*Card, error)
 	GetCardsByID(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*Cards, error)
+	SearchCards(ctx context.Context, in *CardSearchRequest, opts ...grpc.CallOption) (*CardSearchResults, error)
+	SearchCardText(ctx context.Context, in *CardTextSearchRequest, opts ...grpc.CallOption) (*CardTextSearchResults, error)
+	StreamAllCards(ctx context.Context, in *CardStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CardList], error)
 	GetCardsByName(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*Cards, error)
+	GetCardNameSuggestions(ctx context.Context, in *CardNameSuggestionRequest, opts ...grpc.CallOption) (*CardNameSuggestions, error)
 	GetCardsReferencingNameInEffect(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*CardList, error)
+	GetExtraDeckMonstersUsingMaterial(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*MaterialUsages, error)
+	GetCardEffectBreakdown(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*CardEffectBreakdown, error)
+	GetCardReferenceGraph(ctx context.Context, in *CardReferenceGraphRequest, opts ...grpc.CallOption) (*CardReferenceGraph, error)
+	GetSimilarCards(ctx context.Context, in *SimilarCardsRequest, opts ...grpc.CallOption) (*SimilarCards, error)
 	GetArchetypalCardsUsingCardName(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*CardList, error)
 	GetExplicitArchetypalInclusions(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*CardList, error)
 	GetExplicitArchetypalExclusions(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*CardList, error)
+	GetArchetypeMembers(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*ArchetypeMembers, error)
+	ListArchetypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ArchetypeCatalog, error)
 	GetRandomCard(ctx context.Context, in *BlackListed, opts ...grpc.CallOption) (*Card, error)
+	GetRandomCards(ctx context.Context, in *BlackListed, opts ...grpc.CallOption) (*RandomCards, error)
+	GetCardOfTheDay(ctx context.Context, in *CardOfTheDayRequest, opts ...grpc.CallOption) (*CardOfTheDay, error)
 }
 
 type cardServiceClient struct {
@@ -84,6 +108,45 @@ func (c *cardServiceClient) GetCardsByID(ctx context.Context, in *ResourceIDs, o
 	return out, nil
 }
 
+func (c *cardServiceClient) SearchCards(ctx context.Context, in *CardSearchRequest, opts ...grpc.CallOption) (*CardSearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardSearchResults)
+	err := c.cc.Invoke(ctx, CardService_SearchCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SearchCardText(ctx context.Context, in *CardTextSearchRequest, opts ...grpc.CallOption) (*CardTextSearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardTextSearchResults)
+	err := c.cc.Invoke(ctx, CardService_SearchCardText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) StreamAllCards(ctx context.Context, in *CardStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CardList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CardService_ServiceDesc.Streams[0], CardService_StreamAllCards_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CardStreamRequest, CardList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_StreamAllCardsClient = grpc.ServerStreamingClient[CardList]
+
 func (c *cardServiceClient) GetCardsByName(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*Cards, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Cards)
@@ -94,6 +157,16 @@ func (c *cardServiceClient) GetCardsByName(ctx context.Context, in *ResourceName
 	return out, nil
 }
 
+func (c *cardServiceClient) GetCardNameSuggestions(ctx context.Context, in *CardNameSuggestionRequest, opts ...grpc.CallOption) (*CardNameSuggestions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardNameSuggestions)
+	err := c.cc.Invoke(ctx, CardService_GetCardNameSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cardServiceClient) GetCardsReferencingNameInEffect(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*CardList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CardList)
@@ -104,6 +177,46 @@ func (c *cardServiceClient) GetCardsReferencingNameInEffect(ctx context.Context,
 	return out, nil
 }
 
+func (c *cardServiceClient) GetExtraDeckMonstersUsingMaterial(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*MaterialUsages, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterialUsages)
+	err := c.cc.Invoke(ctx, CardService_GetExtraDeckMonstersUsingMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardEffectBreakdown(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*CardEffectBreakdown, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardEffectBreakdown)
+	err := c.cc.Invoke(ctx, CardService_GetCardEffectBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardReferenceGraph(ctx context.Context, in *CardReferenceGraphRequest, opts ...grpc.CallOption) (*CardReferenceGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardReferenceGraph)
+	err := c.cc.Invoke(ctx, CardService_GetCardReferenceGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetSimilarCards(ctx context.Context, in *SimilarCardsRequest, opts ...grpc.CallOption) (*SimilarCards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimilarCards)
+	err := c.cc.Invoke(ctx, CardService_GetSimilarCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cardServiceClient) GetArchetypalCardsUsingCardName(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*CardList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CardList)
@@ -134,6 +247,26 @@ func (c *cardServiceClient) GetExplicitArchetypalExclusions(ctx context.Context,
 	return out, nil
 }
 
+func (c *cardServiceClient) GetArchetypeMembers(ctx context.Context, in *Archetype, opts ...grpc.CallOption) (*ArchetypeMembers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchetypeMembers)
+	err := c.cc.Invoke(ctx, CardService_GetArchetypeMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ListArchetypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ArchetypeCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchetypeCatalog)
+	err := c.cc.Invoke(ctx, CardService_ListArchetypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cardServiceClient) GetRandomCard(ctx context.Context, in *BlackListed, opts ...grpc.CallOption) (*Card, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Card)
@@ -144,6 +277,26 @@ func (c *cardServiceClient) GetRandomCard(ctx context.Context, in *BlackListed,
 	return out, nil
 }
 
+func (c *cardServiceClient) GetRandomCards(ctx context.Context, in *BlackListed, opts ...grpc.CallOption) (*RandomCards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RandomCards)
+	err := c.cc.Invoke(ctx, CardService_GetRandomCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardOfTheDay(ctx context.Context, in *CardOfTheDayRequest, opts ...grpc.CallOption) (*CardOfTheDay, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardOfTheDay)
+	err := c.cc.Invoke(ctx, CardService_GetCardOfTheDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CardServiceServer is the server API for CardService service.
 // All implementations must embed UnimplementedCardServiceServer
 // for forward compatibility.
@@ -151,12 +304,24 @@ type CardServiceServer interface {
 	GetCardColors(context.Context, *emptypb.Empty) (*CardColors, error)
 	GetCardByID(context.Context, *ResourceID) (*Card, error)
 	GetCardsByID(context.Context, *ResourceIDs) (*Cards, error)
+	SearchCards(context.Context, *CardSearchRequest) (*CardSearchResults, error)
+	SearchCardText(context.Context, *CardTextSearchRequest) (*CardTextSearchResults, error)
+	StreamAllCards(*CardStreamRequest, grpc.ServerStreamingServer[CardList]) error
 	GetCardsByName(context.Context, *ResourceNames) (*Cards, error)
+	GetCardNameSuggestions(context.Context, *CardNameSuggestionRequest) (*CardNameSuggestions, error)
 	GetCardsReferencingNameInEffect(context.Context, *ResourceNames) (*CardList, error)
+	GetExtraDeckMonstersUsingMaterial(context.Context, *ResourceID) (*MaterialUsages, error)
+	GetCardEffectBreakdown(context.Context, *ResourceID) (*CardEffectBreakdown, error)
+	GetCardReferenceGraph(context.Context, *CardReferenceGraphRequest) (*CardReferenceGraph, error)
+	GetSimilarCards(context.Context, *SimilarCardsRequest) (*SimilarCards, error)
 	GetArchetypalCardsUsingCardName(context.Context, *Archetype) (*CardList, error)
 	GetExplicitArchetypalInclusions(context.Context, *Archetype) (*CardList, error)
 	GetExplicitArchetypalExclusions(context.Context, *Archetype) (*CardList, error)
+	GetArchetypeMembers(context.Context, *Archetype) (*ArchetypeMembers, error)
+	ListArchetypes(context.Context, *emptypb.Empty) (*ArchetypeCatalog, error)
 	GetRandomCard(context.Context, *BlackListed) (*Card, error)
+	GetRandomCards(context.Context, *BlackListed) (*RandomCards, error)
+	GetCardOfTheDay(context.Context, *CardOfTheDayRequest) (*CardOfTheDay, error)
 	mustEmbedUnimplementedCardServiceServer()
 }
 
@@ -176,12 +341,36 @@ func (UnimplementedCardServiceServer) GetCardByID(context.Context, *ResourceID)
 func (UnimplementedCardServiceServer) GetCardsByID(context.Context, *ResourceIDs) (*Cards, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCardsByID not implemented")
 }
+func (UnimplementedCardServiceServer) SearchCards(context.Context, *CardSearchRequest) (*CardSearchResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchCards not implemented")
+}
+func (UnimplementedCardServiceServer) SearchCardText(context.Context, *CardTextSearchRequest) (*CardTextSearchResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchCardText not implemented")
+}
+func (UnimplementedCardServiceServer) StreamAllCards(*CardStreamRequest, grpc.ServerStreamingServer[CardList]) error {
+	return status.Error(codes.Unimplemented, "method StreamAllCards not implemented")
+}
 func (UnimplementedCardServiceServer) GetCardsByName(context.Context, *ResourceNames) (*Cards, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCardsByName not implemented")
 }
+func (UnimplementedCardServiceServer) GetCardNameSuggestions(context.Context, *CardNameSuggestionRequest) (*CardNameSuggestions, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardNameSuggestions not implemented")
+}
 func (UnimplementedCardServiceServer) GetCardsReferencingNameInEffect(context.Context, *ResourceNames) (*CardList, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCardsReferencingNameInEffect not implemented")
 }
+func (UnimplementedCardServiceServer) GetExtraDeckMonstersUsingMaterial(context.Context, *ResourceID) (*MaterialUsages, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExtraDeckMonstersUsingMaterial not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardEffectBreakdown(context.Context, *ResourceID) (*CardEffectBreakdown, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardEffectBreakdown not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardReferenceGraph(context.Context, *CardReferenceGraphRequest) (*CardReferenceGraph, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardReferenceGraph not implemented")
+}
+func (UnimplementedCardServiceServer) GetSimilarCards(context.Context, *SimilarCardsRequest) (*SimilarCards, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSimilarCards not implemented")
+}
 func (UnimplementedCardServiceServer) GetArchetypalCardsUsingCardName(context.Context, *Archetype) (*CardList, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetArchetypalCardsUsingCardName not implemented")
 }
@@ -191,9 +380,21 @@ func (UnimplementedCardServiceServer) GetExplicitArchetypalInclusions(context.Co
 func (UnimplementedCardServiceServer) GetExplicitArchetypalExclusions(context.Context, *Archetype) (*CardList, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExplicitArchetypalExclusions not implemented")
 }
+func (UnimplementedCardServiceServer) GetArchetypeMembers(context.Context, *Archetype) (*ArchetypeMembers, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetArchetypeMembers not implemented")
+}
+func (UnimplementedCardServiceServer) ListArchetypes(context.Context, *emptypb.Empty) (*ArchetypeCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArchetypes not implemented")
+}
 func (UnimplementedCardServiceServer) GetRandomCard(context.Context, *BlackListed) (*Card, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRandomCard not implemented")
 }
+func (UnimplementedCardServiceServer) GetRandomCards(context.Context, *BlackListed) (*RandomCards, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRandomCards not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardOfTheDay(context.Context, *CardOfTheDayRequest) (*CardOfTheDay, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardOfTheDay not implemented")
+}
 func (UnimplementedCardServiceServer) mustEmbedUnimplementedCardServiceServer() {}
 func (UnimplementedCardServiceServer) testEmbeddedByValue()                     {}
 
@@ -269,6 +470,53 @@ func _CardService_GetCardsByID_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_SearchCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SearchCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SearchCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SearchCards(ctx, req.(*CardSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SearchCardText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardTextSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SearchCardText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SearchCardText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SearchCardText(ctx, req.(*CardTextSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_StreamAllCards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CardStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CardServiceServer).StreamAllCards(m, &grpc.GenericServerStream[CardStreamRequest, CardList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_StreamAllCardsServer = grpc.ServerStreamingServer[CardList]
+
 func _CardService_GetCardsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourceNames)
 	if err := dec(in); err != nil {
@@ -287,6 +535,24 @@ func _CardService_GetCardsByName_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_GetCardNameSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardNameSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardNameSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetCardNameSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardNameSuggestions(ctx, req.(*CardNameSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CardService_GetCardsReferencingNameInEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourceNames)
 	if err := dec(in); err != nil {
@@ -305,6 +571,78 @@ func _CardService_GetCardsReferencingNameInEffect_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_GetExtraDeckMonstersUsingMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetExtraDeckMonstersUsingMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetExtraDeckMonstersUsingMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetExtraDeckMonstersUsingMaterial(ctx, req.(*ResourceID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardEffectBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardEffectBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetCardEffectBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardEffectBreakdown(ctx, req.(*ResourceID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardReferenceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardReferenceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardReferenceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetCardReferenceGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardReferenceGraph(ctx, req.(*CardReferenceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetSimilarCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetSimilarCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetSimilarCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetSimilarCards(ctx, req.(*SimilarCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CardService_GetArchetypalCardsUsingCardName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Archetype)
 	if err := dec(in); err != nil {
@@ -359,6 +697,42 @@ func _CardService_GetExplicitArchetypalExclusions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_GetArchetypeMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Archetype)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetArchetypeMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetArchetypeMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetArchetypeMembers(ctx, req.(*Archetype))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ListArchetypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ListArchetypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ListArchetypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ListArchetypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CardService_GetRandomCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlackListed)
 	if err := dec(in); err != nil {
@@ -377,6 +751,42 @@ func _CardService_GetRandomCard_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_GetRandomCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackListed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetRandomCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetRandomCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetRandomCards(ctx, req.(*BlackListed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardOfTheDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardOfTheDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardOfTheDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_GetCardOfTheDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardOfTheDay(ctx, req.(*CardOfTheDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CardService_ServiceDesc is the grpc.ServiceDesc for CardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,12 +807,40 @@ var CardService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CardService_GetCardsByID_Handler,
 		},
 		{
-			MethodName: "GetCardsByName",
-			Handler:    _CardService_GetCardsByName_Handler,
+			MethodName: "SearchCards",
+			Handler:    _CardService_SearchCards_Handler,
 		},
 		{
-			MethodName: "GetCardsReferencingNameInEffect",
-			Handler:    _CardService_GetCardsReferencingNameInEffect_Handler,
+			MethodName: "SearchCardText",
+			Handler:    _CardService_SearchCardText_Handler,
+		},
+		{
+			MethodName: "GetCardsByName",
+			Handler:    _CardService_GetCardsByName_Handler,
+		},
+		{
+			MethodName: "GetCardNameSuggestions",
+			Handler:    _CardService_GetCardNameSuggestions_Handler,
+		},
+		{
+			MethodName: "GetCardsReferencingNameInEffect",
+			Handler:    _CardService_GetCardsReferencingNameInEffect_Handler,
+		},
+		{
+			MethodName: "GetExtraDeckMonstersUsingMaterial",
+			Handler:    _CardService_GetExtraDeckMonstersUsingMaterial_Handler,
+		},
+		{
+			MethodName: "GetCardEffectBreakdown",
+			Handler:    _CardService_GetCardEffectBreakdown_Handler,
+		},
+		{
+			MethodName: "GetCardReferenceGraph",
+			Handler:    _CardService_GetCardReferenceGraph_Handler,
+		},
+		{
+			MethodName: "GetSimilarCards",
+			Handler:    _CardService_GetSimilarCards_Handler,
 		},
 		{
 			MethodName: "GetArchetypalCardsUsingCardName",
@@ -416,19 +854,46 @@ var CardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExplicitArchetypalExclusions",
 			Handler:    _CardService_GetExplicitArchetypalExclusions_Handler,
 		},
+		{
+			MethodName: "GetArchetypeMembers",
+			Handler:    _CardService_GetArchetypeMembers_Handler,
+		},
+		{
+			MethodName: "ListArchetypes",
+			Handler:    _CardService_ListArchetypes_Handler,
+		},
 		{
 			MethodName: "GetRandomCard",
 			Handler:    _CardService_GetRandomCard_Handler,
 		},
+		{
+			MethodName: "GetRandomCards",
+			Handler:    _CardService_GetRandomCards_Handler,
+		},
+		{
+			MethodName: "GetCardOfTheDay",
+			Handler:    _CardService_GetCardOfTheDay_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAllCards",
+			Handler:       _CardService_StreamAllCards_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ygo_service.proto",
 }
 
 const (
-	ProductService_GetCardsByProductID_FullMethodName    = "/ygo.ProductService/GetCardsByProductID"
-	ProductService_GetProductSummaryByID_FullMethodName  = "/ygo.ProductService/GetProductSummaryByID"
-	ProductService_GetProductsSummaryByID_FullMethodName = "/ygo.ProductService/GetProductsSummaryByID"
+	ProductService_GetCardsByProductID_FullMethodName      = "/ygo.ProductService/GetCardsByProductID"
+	ProductService_GetProductSummaryByID_FullMethodName    = "/ygo.ProductService/GetProductSummaryByID"
+	ProductService_GetProductsSummaryByID_FullMethodName   = "/ygo.ProductService/GetProductsSummaryByID"
+	ProductService_GetProductsSummaryByName_FullMethodName = "/ygo.ProductService/GetProductsSummaryByName"
+	ProductService_SearchProducts_FullMethodName           = "/ygo.ProductService/SearchProducts"
+	ProductService_GetCardsByPrintCode_FullMethodName      = "/ygo.ProductService/GetCardsByPrintCode"
+	ProductService_GetCardPrintings_FullMethodName         = "/ygo.ProductService/GetCardPrintings"
+	ProductService_GetCardPrintingsByIDs_FullMethodName    = "/ygo.ProductService/GetCardPrintingsByIDs"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -438,6 +903,11 @@ type ProductServiceClient interface {
 	GetCardsByProductID(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*Product, error)
 	GetProductSummaryByID(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*ProductSummary, error)
 	GetProductsSummaryByID(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*Products, error)
+	GetProductsSummaryByName(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*Products, error)
+	SearchProducts(ctx context.Context, in *ProductSearchRequest, opts ...grpc.CallOption) (*ProductSearchResults, error)
+	GetCardsByPrintCode(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*PrintedCards, error)
+	GetCardPrintings(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*CardPrintings, error)
+	GetCardPrintingsByIDs(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*BatchCardPrintings, error)
 }
 
 type productServiceClient struct {
@@ -478,6 +948,56 @@ func (c *productServiceClient) GetProductsSummaryByID(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductsSummaryByName(ctx context.Context, in *ResourceNames, opts ...grpc.CallOption) (*Products, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Products)
+	err := c.cc.Invoke(ctx, ProductService_GetProductsSummaryByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *ProductSearchRequest, opts ...grpc.CallOption) (*ProductSearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSearchResults)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCardsByPrintCode(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*PrintedCards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrintedCards)
+	err := c.cc.Invoke(ctx, ProductService_GetCardsByPrintCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCardPrintings(ctx context.Context, in *ResourceID, opts ...grpc.CallOption) (*CardPrintings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardPrintings)
+	err := c.cc.Invoke(ctx, ProductService_GetCardPrintings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCardPrintingsByIDs(ctx context.Context, in *ResourceIDs, opts ...grpc.CallOption) (*BatchCardPrintings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCardPrintings)
+	err := c.cc.Invoke(ctx, ProductService_GetCardPrintingsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -485,6 +1005,11 @@ type ProductServiceServer interface {
 	GetCardsByProductID(context.Context, *ResourceID) (*Product, error)
 	GetProductSummaryByID(context.Context, *ResourceID) (*ProductSummary, error)
 	GetProductsSummaryByID(context.Context, *ResourceIDs) (*Products, error)
+	GetProductsSummaryByName(context.Context, *ResourceNames) (*Products, error)
+	SearchProducts(context.Context, *ProductSearchRequest) (*ProductSearchResults, error)
+	GetCardsByPrintCode(context.Context, *ResourceIDs) (*PrintedCards, error)
+	GetCardPrintings(context.Context, *ResourceID) (*CardPrintings, error)
+	GetCardPrintingsByIDs(context.Context, *ResourceIDs) (*BatchCardPrintings, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -504,6 +1029,21 @@ func (UnimplementedProductServiceServer) GetProductSummaryByID(context.Context,
 func (UnimplementedProductServiceServer) GetProductsSummaryByID(context.Context, *ResourceIDs) (*Products, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProductsSummaryByID not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductsSummaryByName(context.Context, *ResourceNames) (*Products, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductsSummaryByName not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *ProductSearchRequest) (*ProductSearchResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetCardsByPrintCode(context.Context, *ResourceIDs) (*PrintedCards, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardsByPrintCode not implemented")
+}
+func (UnimplementedProductServiceServer) GetCardPrintings(context.Context, *ResourceID) (*CardPrintings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardPrintings not implemented")
+}
+func (UnimplementedProductServiceServer) GetCardPrintingsByIDs(context.Context, *ResourceIDs) (*BatchCardPrintings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardPrintingsByIDs not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -579,6 +1119,96 @@ func _ProductService_GetProductsSummaryByID_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetProductsSummaryByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceNames)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductsSummaryByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductsSummaryByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductsSummaryByName(ctx, req.(*ResourceNames))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*ProductSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCardsByPrintCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCardsByPrintCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCardsByPrintCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCardsByPrintCode(ctx, req.(*ResourceIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCardPrintings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCardPrintings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCardPrintings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCardPrintings(ctx, req.(*ResourceID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCardPrintingsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCardPrintingsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCardPrintingsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCardPrintingsByIDs(ctx, req.(*ResourceIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -598,6 +1228,26 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductsSummaryByID",
 			Handler:    _ProductService_GetProductsSummaryByID_Handler,
 		},
+		{
+			MethodName: "GetProductsSummaryByName",
+			Handler:    _ProductService_GetProductsSummaryByName_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "GetCardsByPrintCode",
+			Handler:    _ProductService_GetCardsByPrintCode_Handler,
+		},
+		{
+			MethodName: "GetCardPrintings",
+			Handler:    _ProductService_GetCardPrintings_Handler,
+		},
+		{
+			MethodName: "GetCardPrintingsByIDs",
+			Handler:    _ProductService_GetCardPrintingsByIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ygo_service.proto",
@@ -706,6 +1356,374 @@ var CardRestrictionService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "ygo_service.proto",
 }
 
+const (
+	CatalogService_GetCardColorCatalog_FullMethodName = "/ygo.CatalogService/GetCardColorCatalog"
+	CatalogService_GetCardAttributes_FullMethodName   = "/ygo.CatalogService/GetCardAttributes"
+	CatalogService_GetMonsterTypes_FullMethodName     = "/ygo.CatalogService/GetMonsterTypes"
+	CatalogService_GetMonsterAbilities_FullMethodName = "/ygo.CatalogService/GetMonsterAbilities"
+	CatalogService_GetProductTypes_FullMethodName     = "/ygo.CatalogService/GetProductTypes"
+	CatalogService_GetProductSubTypes_FullMethodName  = "/ygo.CatalogService/GetProductSubTypes"
+	CatalogService_GetRarities_FullMethodName         = "/ygo.CatalogService/GetRarities"
+	CatalogService_GetLocales_FullMethodName          = "/ygo.CatalogService/GetLocales"
+)
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CatalogServiceClient interface {
+	GetCardColorCatalog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CardColorCatalog, error)
+	GetCardAttributes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetMonsterTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetMonsterAbilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetProductTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetProductSubTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetRarities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+	GetLocales(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) GetCardColorCatalog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CardColorCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardColorCatalog)
+	err := c.cc.Invoke(ctx, CatalogService_GetCardColorCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetCardAttributes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetCardAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetMonsterTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetMonsterTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetMonsterAbilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetMonsterAbilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetProductTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetProductTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetProductSubTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetProductSubTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetRarities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetRarities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetLocales(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CatalogEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntries)
+	err := c.cc.Invoke(ctx, CatalogService_GetLocales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+// All implementations must embed UnimplementedCatalogServiceServer
+// for forward compatibility.
+type CatalogServiceServer interface {
+	GetCardColorCatalog(context.Context, *emptypb.Empty) (*CardColorCatalog, error)
+	GetCardAttributes(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetMonsterTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetMonsterAbilities(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetProductTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetProductSubTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetRarities(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	GetLocales(context.Context, *emptypb.Empty) (*CatalogEntries, error)
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+// UnimplementedCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServiceServer struct{}
+
+func (UnimplementedCatalogServiceServer) GetCardColorCatalog(context.Context, *emptypb.Empty) (*CardColorCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardColorCatalog not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetCardAttributes(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCardAttributes not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetMonsterTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMonsterTypes not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetMonsterAbilities(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMonsterAbilities not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetProductTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductTypes not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetProductSubTypes(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductSubTypes not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetRarities(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRarities not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetLocales(context.Context, *emptypb.Empty) (*CatalogEntries, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLocales not implemented")
+}
+func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
+func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServiceServer will
+// result in compilation errors.
+type UnsafeCatalogServiceServer interface {
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+func RegisterCatalogServiceServer(s grpc.ServiceRegistrar, srv CatalogServiceServer) {
+	// If the following call panics, it indicates UnimplementedCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogService_ServiceDesc, srv)
+}
+
+func _CatalogService_GetCardColorCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCardColorCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCardColorCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCardColorCatalog(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetCardAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCardAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCardAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCardAttributes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetMonsterTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetMonsterTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetMonsterTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetMonsterTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetMonsterAbilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetMonsterAbilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetMonsterAbilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetMonsterAbilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetProductTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetProductTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetProductTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetProductTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetProductSubTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetProductSubTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetProductSubTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetProductSubTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetRarities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetRarities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetRarities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetRarities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetLocales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetLocales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetLocales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetLocales(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ygo.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCardColorCatalog",
+			Handler:    _CatalogService_GetCardColorCatalog_Handler,
+		},
+		{
+			MethodName: "GetCardAttributes",
+			Handler:    _CatalogService_GetCardAttributes_Handler,
+		},
+		{
+			MethodName: "GetMonsterTypes",
+			Handler:    _CatalogService_GetMonsterTypes_Handler,
+		},
+		{
+			MethodName: "GetMonsterAbilities",
+			Handler:    _CatalogService_GetMonsterAbilities_Handler,
+		},
+		{
+			MethodName: "GetProductTypes",
+			Handler:    _CatalogService_GetProductTypes_Handler,
+		},
+		{
+			MethodName: "GetProductSubTypes",
+			Handler:    _CatalogService_GetProductSubTypes_Handler,
+		},
+		{
+			MethodName: "GetRarities",
+			Handler:    _CatalogService_GetRarities_Handler,
+		},
+		{
+			MethodName: "GetLocales",
+			Handler:    _CatalogService_GetLocales_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ygo_service.proto",
+}
+
 const (
 	ScoreService_GetScoresByFormatAndDate_FullMethodName = "/ygo.ScoreService/GetScoresByFormatAndDate"
 	ScoreService_GetCardScoreByID_FullMethodName         = "/ygo.ScoreService/GetCardScoreByID"
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	cardOfTheDayDateFormat    = "2006-01-02"
+	cardOfTheDaySeed          = 0x5ca1ab1e
+	cardOfTheDayWindowEnvKey  = "CARD_OF_THE_DAY_WINDOW_DAYS"
+	defaultCardOfTheDayWindow = 30
+)
+
+// candidates of the current cycle, frozen the first time they are needed so cards added mid cycle do not change the picks of the cycle
+type cardOfTheDayCandidates struct {
+	cycle  int64
+	window int
+	ids    []string
+}
+
+var (
+	currentCardOfTheDayCandidates atomic.Pointer[cardOfTheDayCandidates]
+	todaysCardOfTheDay            atomic.Pointer[ygo.CardOfTheDay]
+)
+
+func (s *ygoCardServiceServer) GetCardOfTheDay(ctx context.Context, req *ygo.CardOfTheDayRequest) (*ygo.CardOfTheDay, error) {
+	today := time.Now().In(chicagoLocation)
+	date := today
+	if req.Date != "" {
+		var err error
+		if date, err = time.ParseInLocation(cardOfTheDayDateFormat, req.Date, chicagoLocation); err != nil {
+			return nil, status.New(codes.InvalidArgument, "Date needs to use YYYY-MM-DD format").Err()
+		}
+	}
+	window := cardOfTheDayWindow()
+	logger, newCtx := util.NewLogger(ctx, "Card Of The Day", slog.String("date", date.Format(cardOfTheDayDateFormat)), slog.Int("window", window))
+
+	formattedDate := date.Format(cardOfTheDayDateFormat)
+	isToday := formattedDate == today.Format(cardOfTheDayDateFormat)
+	if cached := todaysCardOfTheDay.Load(); isToday && cached != nil && cached.Date == formattedDate {
+		logger.Info(fmt.Sprintf("Using cached card of the day; ID: %s, Name: %s", cached.Card.ID, cached.Card.Name))
+		return cached, nil
+	}
+
+	day := daysSinceEpoch(date)
+	candidates, err := cardOfTheDayCandidateIDs(newCtx, day, daysSinceEpoch(today), window)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	cardID, isOk := cardOfTheDayID(candidates, day, window)
+	if !isOk {
+		return nil, status.New(codes.NotFound, "No cards available").Err()
+	}
+	c, err := cardRepo.GetCardByID(newCtx, cardID)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	cardOfTheDay := &ygo.CardOfTheDay{Date: formattedDate, Card: c}
+	if isToday {
+		todaysCardOfTheDay.Store(cardOfTheDay)
+	}
+	logger.Info(fmt.Sprintf("Card of the day determined to be; ID: %s, Name: %s", c.ID, c.Name))
+	return cardOfTheDay, nil
+}
+
+// Only candidates of the current cycle are frozen, days of other cycles use the cards currently in the DB.
+func cardOfTheDayCandidateIDs(ctx context.Context, day, today int64, window int) ([]string, *status.Status) {
+	cycle, _ := cardOfTheDayCycle(day, window)
+	currentCycle, _ := cardOfTheDayCycle(today, window)
+	if frozen := currentCardOfTheDayCandidates.Load(); frozen != nil && frozen.cycle == cycle && frozen.window == window {
+		return frozen.ids, nil
+	}
+
+	candidates, err := cardRepo.GetRandomCardCandidates(ctx, &ygo.BlackListed{})
+	if err != nil {
+		return nil, err
+	}
+	if cycle == currentCycle {
+		currentCardOfTheDayCandidates.Store(&cardOfTheDayCandidates{cycle: cycle, window: window, ids: candidates})
+	}
+	return candidates, nil
+}
+
+func cardOfTheDayWindow() int {
+	if window, err := strconv.Atoi(util.EnvMap[cardOfTheDayWindowEnvKey]); err == nil && window > 0 {
+		return window
+	}
+	return defaultCardOfTheDayWindow
+}
+
+// uses the calendar day so all times during a day in Chicago map to the same value
+func daysSinceEpoch(date time.Time) int64 {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix() / int64(24*time.Hour/time.Second)
+}
+
+// Days are grouped into cycles the size of the window. Each cycle ranks the cards of a pool by a hash of the cycle and card ID and picks cards in rank order,
+// alternating between two disjoint pools so the end of one cycle and the start of the next cannot share cards either.
+// Any stretch of window days therefore has no repeats, as long as each pool has at least window cards.
+// Pools and ranks only depend on the card itself, so the order of candidates doesn't matter and adding a card only moves the days ranked after it.
+func cardOfTheDayID(candidates []string, day int64, window int) (string, bool) {
+	cycle, position := cardOfTheDayCycle(day, window)
+
+	type rankedCard struct {
+		id   string
+		rank uint64
+	}
+	pool := make([]rankedCard, 0, len(candidates)/2+1)
+	for _, id := range candidates {
+		if cardOfTheDayPool(id) == uint32(cycle&1) {
+			pool = append(pool, rankedCard{id: id, rank: cardOfTheDayRank(cycle, id)})
+		}
+	}
+	if len(pool) == 0 {
+		return "", false
+	}
+
+	slices.SortFunc(pool, func(a, b rankedCard) int {
+		return cmp.Or(cmp.Compare(a.rank, b.rank), cmp.Compare(a.id, b.id))
+	})
+	return pool[position%len(pool)].id, true
+}
+
+// floored division so dates before 1970 still map to a valid position
+func cardOfTheDayCycle(day int64, window int) (cycle int64, position int) {
+	cycle, position = day/int64(window), int(day%int64(window))
+	if position < 0 {
+		cycle, position = cycle-1, position+window
+	}
+	return cycle, position
+}
+
+func cardOfTheDayPool(cardID string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(cardID))
+	return h.Sum32() % 2
+}
+
+func cardOfTheDayRank(cycle int64, cardID string) uint64 {
+	h := fnv.New64a()
+	h.Write(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, cardOfTheDaySeed), uint64(cycle)))
+	h.Write([]byte(cardID))
+	return h.Sum64()
+}
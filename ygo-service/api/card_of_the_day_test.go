@@ -0,0 +1,186 @@
+package api
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+func cardIDs(total int) []string {
+	ids := make([]string, total)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%08d", 10000000+i*137)
+	}
+	return ids
+}
+
+func cardsWithIDs(ids []string) []*ygo.Card {
+	cards := make([]*ygo.Card, len(ids))
+	for i, id := range ids {
+		cards[i] = &ygo.Card{ID: id, Name: "Card " + id}
+	}
+	return cards
+}
+
+// clears the frozen candidates and cached card of the day before and after a test
+func resetCardOfTheDay(cleanup func(func())) {
+	currentCardOfTheDayCandidates.Store(nil)
+	todaysCardOfTheDay.Store(nil)
+	cleanup(func() {
+		currentCardOfTheDayCandidates.Store(nil)
+		todaysCardOfTheDay.Store(nil)
+	})
+}
+
+func TestDaysSinceEpoch(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		date     time.Time
+		expected int64
+	}{
+		{testName: "Epoch", date: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), expected: 0},
+		{testName: "Late in the day in Chicago", date: time.Date(1970, 1, 2, 23, 59, 0, 0, chicagoLocation), expected: 1},
+		{testName: "Before epoch", date: time.Date(1969, 12, 31, 12, 0, 0, 0, chicagoLocation), expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, daysSinceEpoch(tt.date))
+		})
+	}
+}
+
+func TestCardOfTheDayIDIsDeterministic(t *testing.T) {
+	assert := assert.New(t)
+	candidates := cardIDs(500)
+
+	shuffled := slices.Clone(candidates)
+	rand.New(rand.NewPCG(1, 2)).Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	tests := []struct {
+		testName string
+		day      int64
+		window   int
+	}{
+		{testName: "Start of cycle", day: 20000, window: 30},
+		{testName: "End of cycle", day: 20009, window: 10},
+		{testName: "Before epoch", day: -45, window: 30},
+		{testName: "Window of one day", day: 20001, window: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			expected, isOk := cardOfTheDayID(candidates, tt.day, tt.window)
+			assert.True(isOk)
+			assert.Contains(candidates, expected)
+
+			id, _ := cardOfTheDayID(candidates, tt.day, tt.window)
+			assert.Equal(expected, id, "Same day should return same card")
+			id, _ = cardOfTheDayID(shuffled, tt.day, tt.window)
+			assert.Equal(expected, id, "Order of candidates should not matter")
+		})
+	}
+}
+
+func TestCardOfTheDayIDHasNoRepeatsWithinWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName   string
+		candidates []string
+		window     int
+	}{
+		{testName: "Default window", candidates: cardIDs(500), window: defaultCardOfTheDayWindow},
+		{testName: "Small window", candidates: cardIDs(50), window: 7},
+		{testName: "Pools barely larger than window", candidates: cardIDs(80), window: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			// spans multiple cycles, including ones before epoch
+			picks := make([]string, 0)
+			for day := int64(-3 * tt.window); day < int64(4*tt.window); day++ {
+				id, isOk := cardOfTheDayID(tt.candidates, day, tt.window)
+				assert.True(isOk)
+				picks = append(picks, id)
+			}
+
+			for start := 0; start+tt.window <= len(picks); start++ {
+				seen := make(map[string]struct{}, tt.window)
+				for _, id := range picks[start : start+tt.window] {
+					seen[id] = struct{}{}
+				}
+				assert.Len(seen, tt.window, "Days %d to %d should not repeat cards", start, start+tt.window-1)
+			}
+		})
+	}
+}
+
+func TestCardOfTheDayIDWithNewCard(t *testing.T) {
+	assert := assert.New(t)
+	candidates := cardIDs(200)
+	day, window := int64(20015), 30 // middle of a cycle
+
+	before, _ := cardOfTheDayID(candidates, day, window)
+	withNewCard := append(slices.Clone(candidates), "99999999")
+	after, _ := cardOfTheDayID(withNewCard, day, window)
+
+	// new card can only take the spot of the current card or push it back a day
+	previousDay, _ := cardOfTheDayID(candidates, day-1, window)
+	assert.Contains([]string{before, previousDay, "99999999"}, after)
+}
+
+func TestCardOfTheDayIDWithoutCandidates(t *testing.T) {
+	assert := assert.New(t)
+
+	_, isOk := cardOfTheDayID([]string{}, 20000, defaultCardOfTheDayWindow)
+	assert.False(isOk)
+}
+
+func TestGetCardOfTheDayIsStableWhenCardsAreAdded(t *testing.T) {
+	assert := assert.New(t)
+	resetCardOfTheDay(t.Cleanup)
+	ctx, server := t.Context(), &ygoCardServiceServer{}
+
+	candidates := cardIDs(200)
+	useFakeCardRepo(t.Cleanup, cardsWithIDs(candidates)...)
+	expected, err := server.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{})
+	assert.Nil(err)
+
+	// enough new cards that the pick would almost certainly change if candidates were reloaded
+	useFakeCardRepo(t.Cleanup, cardsWithIDs(append(slices.Clone(candidates), cardIDs(2000)[200:]...))...)
+	actual, err := server.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{})
+	assert.Nil(err)
+	assert.Same(expected, actual, "Card of the day should be cached for the rest of the day")
+
+	todaysCardOfTheDay.Store(nil)
+	actual, err = server.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{Date: expected.Date})
+	assert.Nil(err)
+	assert.Equal(expected.Card.ID, actual.Card.ID, "Candidates should stay frozen for the rest of the cycle")
+}
+
+func TestGetCardOfTheDayForOtherCycle(t *testing.T) {
+	assert := assert.New(t)
+	resetCardOfTheDay(t.Cleanup)
+	ctx, server := t.Context(), &ygoCardServiceServer{}
+
+	candidates := cardIDs(200)
+	useFakeCardRepo(t.Cleanup, cardsWithIDs(candidates)...)
+	_, err := server.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{})
+	assert.Nil(err)
+	frozen := currentCardOfTheDayCandidates.Load()
+
+	date := time.Now().In(chicagoLocation).AddDate(0, 0, -3*defaultCardOfTheDayWindow).Format(cardOfTheDayDateFormat)
+	actual, err := server.GetCardOfTheDay(ctx, &ygo.CardOfTheDayRequest{Date: date})
+	assert.Nil(err)
+	assert.Equal(date, actual.Date)
+	assert.Same(frozen, currentCardOfTheDayCandidates.Load(), "Only candidates of the current cycle are frozen")
+	assert.NotEqual(date, todaysCardOfTheDay.Load().Date, "Only the card of the current day is cached")
+}
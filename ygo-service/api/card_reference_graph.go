@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ygoparser "github.com/ygo-skc/skc-go/common/v2/parser"
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReferenceGraphDepth = 1
+	maxReferenceGraphDepth     = 3
+	maxReferenceGraphNodes     = 150 // popular cards are referenced by hundreds of other cards, limit keeps response size in check
+)
+
+type referenceGraphBuilder struct {
+	graph      *ygo.CardReferenceGraph
+	edges      map[string]struct{}
+	archetypes map[string]struct{}
+}
+
+func (s *ygoCardServiceServer) GetCardReferenceGraph(ctx context.Context, req *ygo.CardReferenceGraphRequest) (*ygo.CardReferenceGraph, error) {
+	depth := defaultReferenceGraphDepth
+	if req.Depth != 0 {
+		depth = min(int(req.Depth), maxReferenceGraphDepth)
+	}
+	logger, newCtx := util.NewLogger(ctx, "Card Reference Graph", slog.String("card_id", req.ID), slog.Int("depth", depth))
+
+	root, err := cardRepo.GetCardByID(newCtx, req.ID)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	b := &referenceGraphBuilder{
+		graph: &ygo.CardReferenceGraph{
+			Cards:      map[string]*ygo.Card{root.ID: root},
+			Archetypes: []string{},
+			Edges:      []*ygo.CardReferenceEdge{},
+		},
+		edges:      make(map[string]struct{}),
+		archetypes: make(map[string]struct{}),
+	}
+
+	frontier := []*ygo.Card{root}
+	for hop := 0; hop < depth && len(frontier) != 0; hop++ {
+		if frontier, err = b.expand(newCtx, frontier); err != nil {
+			return nil, err.Err()
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Graph contains %d card(s), %d archetype(s) and %d edge(s)",
+		len(b.graph.Cards), len(b.graph.Archetypes), len(b.graph.Edges)))
+	return b.graph, nil
+}
+
+// adds every card referenced by (or referencing) cards in frontier and returns cards that were not yet part of the graph
+func (b *referenceGraphBuilder) expand(ctx context.Context, frontier []*ygo.Card) ([]*ygo.Card, *status.Status) {
+	next := make([]*ygo.Card, 0)
+
+	// cards mentioned by frontier. Quoted tokens that are not card names are only archetypes if the effect uses them as one, ie: 1 "HERO" monster
+	tokensByCardID := make(map[string][]ygoparser.QuotedToken, len(frontier))
+	archetypesByCardID := make(map[string]map[string]struct{}, len(frontier))
+	allTokens := make([]string, 0)
+	for _, c := range frontier {
+		tokens := ygoparser.QuotedTokens(c.Effect)
+		tokensByCardID[c.ID] = tokens
+		archetypesByCardID[c.ID] = archetypeReferences(c.Effect)
+		allTokens = append(allTokens, tokens...)
+	}
+
+	mentioned := &ygo.Cards{CardInfo: map[string]*ygo.Card{}}
+	if len(allTokens) != 0 {
+		var err *status.Status
+		if mentioned, err = cardRepo.GetCardsByNames(ctx, allTokens); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range frontier {
+		for _, token := range tokensByCardID[c.ID] {
+			if target, isCard := mentioned.CardInfo[token]; isCard {
+				if target.ID != c.ID && b.addNode(target, &next) {
+					b.addEdge(c.ID, target.ID, ygo.CardReferenceType_MENTIONS)
+				}
+			} else if _, isArchetype := archetypesByCardID[c.ID][token]; isArchetype {
+				b.addArchetype(c.ID, token)
+			}
+		}
+	}
+
+	// cards mentioning frontier
+	names := make([]string, len(frontier))
+	for i, c := range frontier {
+		names[i] = c.Name
+	}
+	referencing, err := cardRepo.GetCardsReferencingNameInEffect(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range referencing.Cards {
+		for _, c := range frontier {
+			// full text search can match names that are not quoted
+			if ref.ID != c.ID && ygoparser.TextContainsSubStr(ref.Effect, c.Name) && b.addNode(ref, &next) {
+				b.addEdge(c.ID, ref.ID, ygo.CardReferenceType_MENTIONED_BY)
+			}
+		}
+	}
+
+	return next, nil
+}
+
+func archetypeReferences(effect string) map[string]struct{} {
+	refs := ygoparser.ParseArchetypeReferences(effect)
+	archetypes := make(map[string]struct{}, len(refs.TreatedAs)+len(refs.NotTreatedAs)+len(refs.Referenced))
+	for _, source := range [][]string{refs.TreatedAs, refs.NotTreatedAs, refs.Referenced} {
+		for _, archetype := range source {
+			archetypes[archetype] = struct{}{}
+		}
+	}
+	return archetypes
+}
+
+// returns true if card is part of the graph. New cards are also added to next so they can be expanded in the following hop.
+func (b *referenceGraphBuilder) addNode(c *ygo.Card, next *[]*ygo.Card) bool {
+	if _, exists := b.graph.Cards[c.ID]; exists {
+		return true
+	}
+	if len(b.graph.Cards) >= maxReferenceGraphNodes {
+		b.graph.Truncated = true
+		return false
+	}
+
+	b.graph.Cards[c.ID] = c
+	*next = append(*next, c)
+	return true
+}
+
+func (b *referenceGraphBuilder) addArchetype(cardID, archetype string) {
+	if _, exists := b.archetypes[archetype]; !exists {
+		b.archetypes[archetype] = struct{}{}
+		b.graph.Archetypes = append(b.graph.Archetypes, archetype)
+	}
+	b.addEdge(cardID, archetype, ygo.CardReferenceType_ARCHETYPE)
+}
+
+// "A mentions B" and "B mentioned by A" describe the same relationship, only the first one discovered is kept
+func (b *referenceGraphBuilder) addEdge(source, target string, t ygo.CardReferenceType) {
+	var key string
+	switch t {
+	case ygo.CardReferenceType_MENTIONS:
+		key = fmt.Sprintf("mention|%s|%s", source, target)
+	case ygo.CardReferenceType_MENTIONED_BY:
+		key = fmt.Sprintf("mention|%s|%s", target, source)
+	default:
+		key = fmt.Sprintf("archetype|%s|%s", source, target)
+	}
+
+	if _, exists := b.edges[key]; !exists {
+		b.edges[key] = struct{}{}
+		b.graph.Edges = append(b.graph.Edges, &ygo.CardReferenceEdge{Source: source, Target: target, Type: t})
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	darkMagician     = &ygo.Card{ID: "46986414", Name: "Dark Magician", Effect: "The ultimate wizard in terms of attack and defense."}
+	darkMagicianGirl = &ygo.Card{ID: "38033121", Name: "Dark Magician Girl",
+		Effect: `Gains 300 ATK for every "Dark Magician" or "Magician of Black Chaos" in the GYs. You can add 1 "Magician" monster from your Deck to your hand.`}
+	magiciansRod = &ygo.Card{ID: "07084129", Name: "Magician's Rod",
+		Effect: `If this card is Normal Summoned: You can add 1 Spell/Trap that mentions "Dark Magician" from your Deck to your hand.`}
+	bondBetweenTeacherAndStudent = &ygo.Card{ID: "16494704", Name: "Bond Between Teacher and Student",
+		Effect: `If you control "Dark Magician": Special Summon 1 "Dark Magician Girl" from your hand, Deck, or GY.`}
+)
+
+func TestGetCardReferenceGraph(t *testing.T) {
+	assert := assert.New(t)
+	useFakeCardRepo(t.Cleanup, darkMagician, darkMagicianGirl, magiciansRod, bondBetweenTeacherAndStudent)
+	s := &ygoCardServiceServer{}
+
+	tests := []struct {
+		testName           string
+		depth              uint32
+		expectedCards      []string
+		expectedArchetypes []string
+		expectedEdges      []*ygo.CardReferenceEdge
+	}{
+		{
+			testName:           "Default depth",
+			expectedCards:      []string{darkMagicianGirl.ID, darkMagician.ID, bondBetweenTeacherAndStudent.ID},
+			expectedArchetypes: []string{"Magician"},
+			expectedEdges: []*ygo.CardReferenceEdge{
+				{Source: darkMagicianGirl.ID, Target: darkMagician.ID, Type: ygo.CardReferenceType_MENTIONS},
+				{Source: darkMagicianGirl.ID, Target: "Magician", Type: ygo.CardReferenceType_ARCHETYPE},
+				{Source: darkMagicianGirl.ID, Target: bondBetweenTeacherAndStudent.ID, Type: ygo.CardReferenceType_MENTIONED_BY},
+			},
+		},
+		{
+			testName:           "Second hop reuses existing nodes and edges",
+			depth:              2,
+			expectedCards:      []string{darkMagicianGirl.ID, darkMagician.ID, bondBetweenTeacherAndStudent.ID, magiciansRod.ID},
+			expectedArchetypes: []string{"Magician"},
+			expectedEdges: []*ygo.CardReferenceEdge{
+				{Source: darkMagicianGirl.ID, Target: darkMagician.ID, Type: ygo.CardReferenceType_MENTIONS},
+				{Source: darkMagicianGirl.ID, Target: "Magician", Type: ygo.CardReferenceType_ARCHETYPE},
+				{Source: darkMagicianGirl.ID, Target: bondBetweenTeacherAndStudent.ID, Type: ygo.CardReferenceType_MENTIONED_BY},
+				{Source: bondBetweenTeacherAndStudent.ID, Target: darkMagician.ID, Type: ygo.CardReferenceType_MENTIONS},
+				{Source: darkMagician.ID, Target: magiciansRod.ID, Type: ygo.CardReferenceType_MENTIONED_BY},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			graph, err := s.GetCardReferenceGraph(context.Background(), &ygo.CardReferenceGraphRequest{ID: darkMagicianGirl.ID, Depth: tt.depth})
+			if !assert.Nil(err) {
+				return
+			}
+
+			cardIDs := make([]string, 0, len(graph.Cards))
+			for id := range graph.Cards {
+				cardIDs = append(cardIDs, id)
+			}
+			assert.ElementsMatch(tt.expectedCards, cardIDs)
+			assert.Equal(tt.expectedArchetypes, graph.Archetypes, "Unknown quoted names should not be archetypes")
+			assert.ElementsMatch(tt.expectedEdges, graph.Edges)
+			assert.False(graph.Truncated)
+		})
+	}
+
+	_, err := s.GetCardReferenceGraph(context.Background(), &ygo.CardReferenceGraphRequest{ID: "00000000"})
+	assert.Equal(codes.NotFound, status.Code(err))
+}
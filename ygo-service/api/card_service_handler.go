@@ -2,12 +2,33 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/ygo-skc/skc-go/common/v2/model"
+	ygoparser "github.com/ygo-skc/skc-go/common/v2/parser"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	defaultCatalogStreamPageSize = 250
+	maxCatalogStreamPageSize     = cardPageSize
+
+	defaultNameSuggestionLimit = 5
+	maxNameSuggestionLimit     = 25
+)
+
 func (s *ygoCardServiceServer) GetCardColors(ctx context.Context, req *emptypb.Empty) (*ygo.CardColors, error) {
 	_, newCtx := util.NewLogger(ctx, "Card Colors")
 
@@ -29,18 +50,193 @@ func (s *ygoCardServiceServer) GetCardsByID(ctx context.Context, req *ygo.Resour
 	return c, err.Err()
 }
 
+func (s *ygoCardServiceServer) SearchCards(ctx context.Context, req *ygo.CardSearchRequest) (*ygo.CardSearchResults, error) {
+	_, newCtx := util.NewLogger(ctx, "Search Cards")
+
+	c, err := cardRepo.SearchCards(newCtx, req)
+	return c, err.Err()
+}
+
+func (s *ygoCardServiceServer) SearchCardText(ctx context.Context, req *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, error) {
+	_, newCtx := util.NewLogger(ctx, "Search Card Text")
+
+	results, err := cardRepo.SearchCardText(newCtx, req)
+	return results, err.Err()
+}
+
+func (s *ygoCardServiceServer) StreamAllCards(req *ygo.CardStreamRequest, stream grpc.ServerStreamingServer[ygo.CardList]) error {
+	logger, newCtx := util.NewLogger(stream.Context(), "Stream All Cards", slog.String("modified_since", req.ModifiedSince))
+
+	var modifiedSince time.Time
+	if req.ModifiedSince != "" {
+		var err error
+		if modifiedSince, err = time.Parse(time.RFC3339, req.ModifiedSince); err != nil {
+			logger.Error("Modified since marker is not a valid RFC 3339 timestamp")
+			return status.New(codes.InvalidArgument, "Invalid modified since marker").Err()
+		}
+	}
+
+	pageSize := catalogStreamPageSize(req.PageSize)
+	lastCardID, numCards := "", 0
+	for {
+		cards, err := cardRepo.GetCardsAfterID(newCtx, lastCardID, modifiedSince, pageSize)
+		if err != nil {
+			return err.Err()
+		}
+
+		for _, chunk := range chunkCardsBySize(cards, maxSendMsgSize) {
+			if err := stream.Send(&ygo.CardList{Cards: chunk}); err != nil {
+				logger.Error(fmt.Sprintf("Error sending chunk to client - %v", err))
+				return err
+			}
+		}
+		if len(cards) != 0 {
+			lastCardID = cards[len(cards)-1].ID
+			numCards += len(cards)
+		}
+
+		if len(cards) < pageSize {
+			logger.Info(fmt.Sprintf("Finished streaming %d card(s)", numCards))
+			return nil
+		}
+	}
+}
+
+func catalogStreamPageSize(requested uint32) int {
+	switch {
+	case requested == 0:
+		return defaultCatalogStreamPageSize
+	case requested > maxCatalogStreamPageSize:
+		return maxCatalogStreamPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// splits a page of cards into chunks whose encoded size stays under maxSize, a card larger than maxSize on its own is sent as its own chunk
+func chunkCardsBySize(cards []*ygo.Card, maxSize int) [][]*ygo.Card {
+	chunks := make([][]*ygo.Card, 0, 1)
+	start, size := 0, 0
+	for i, c := range cards {
+		// each card is a length delimited entry of the repeated cards field (field 1)
+		cardSize := protowire.SizeTag(1) + protowire.SizeBytes(proto.Size(c))
+		if i > start && size+cardSize > maxSize {
+			chunks = append(chunks, cards[start:i])
+			start, size = i, 0
+		}
+		size += cardSize
+	}
+	if start < len(cards) {
+		chunks = append(chunks, cards[start:])
+	}
+	return chunks
+}
+
 func (s *ygoCardServiceServer) GetCardsByName(ctx context.Context, req *ygo.ResourceNames) (*ygo.Cards, error) {
 	_, newCtx := util.NewLogger(ctx, "Query Cards By Name")
 
 	c, err := cardRepo.GetCardsByNames(newCtx, req.Names)
+	if err == nil && req.IncludeSuggestions {
+		idx := cardNameIndex.Load()
+		c.Suggestions = make(map[string]*ygo.CardNameSuggestions, len(c.UnknownResources))
+		for _, name := range c.UnknownResources {
+			c.Suggestions[name] = &ygo.CardNameSuggestions{Suggestions: idx.Suggest(name, defaultNameSuggestionLimit)}
+		}
+	}
 	return c, err.Err()
 }
 
+func (s *ygoCardServiceServer) GetCardNameSuggestions(ctx context.Context, req *ygo.CardNameSuggestionRequest) (*ygo.CardNameSuggestions, error) {
+	logger, _ := util.NewLogger(ctx, "Card Name Suggestions", slog.String("query", req.Query))
+
+	limit := defaultNameSuggestionLimit
+	if req.Limit != 0 {
+		limit = min(int(req.Limit), maxNameSuggestionLimit)
+	}
+
+	suggestions := cardNameIndex.Load().Suggest(req.Query, limit)
+	logger.Info(fmt.Sprintf("Found %d suggestion(s)", len(suggestions)))
+	return &ygo.CardNameSuggestions{Suggestions: suggestions}, nil
+}
+
 func (s *ygoCardServiceServer) GetCardsReferencingNameInEffect(ctx context.Context, req *ygo.ResourceNames) (*ygo.CardList, error) {
 	_, newCtx := util.NewLogger(ctx, "Find Refs Using Card Effect")
 
 	c, err := cardRepo.GetCardsReferencingNameInEffect(newCtx, req.Names)
-	return c, err.Err()
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	if req.IncludeHighlights {
+		c.Highlights = make(map[string]*ygo.TextSpans, len(c.Cards))
+		for _, card := range c.Cards {
+			spans := make([]ygoparser.TextSpan, 0)
+			for _, name := range req.Names {
+				spans = append(spans, ygoparser.QuotedSubStrSpans(card.Effect, name)...)
+			}
+			if len(spans) != 0 {
+				c.Highlights[card.ID] = &ygo.TextSpans{Spans: model.TextSpansToProto(ygoparser.MergeSpans(spans))}
+			}
+		}
+	}
+	return c, nil
+}
+
+func (s *ygoCardServiceServer) GetExtraDeckMonstersUsingMaterial(ctx context.Context, req *ygo.ResourceID) (*ygo.MaterialUsages, error) {
+	logger, newCtx := util.NewLogger(ctx, "Extra Deck Monsters Using Material", slog.String("card_id", req.ID))
+
+	material, err := cardRepo.GetCardByID(newCtx, req.ID)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	usages := &ygo.MaterialUsages{Material: material, NamedExplicitly: []*ygo.Card{}, MatchesGenerically: []*ygo.Card{}}
+	if material.MonsterType == nil {
+		logger.Info("Card is not a monster and cannot be used as material")
+		return usages, nil
+	}
+
+	extraDeckMonsters, err := cachedExtraDeckMonsters(newCtx)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	ygoMaterial := model.YGOCardGRPC{Card: material}
+	for _, c := range extraDeckMonsters {
+		switch model.MatchMaterials(ygoMaterial, c.Materials) {
+		case model.NamedMaterialMatch:
+			usages.NamedExplicitly = append(usages.NamedExplicitly, c)
+		case model.GenericMaterialMatch:
+			usages.MatchesGenerically = append(usages.MatchesGenerically, c)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Card is explicitly named by %d and generically matches %d extra deck monster(s)",
+		len(usages.NamedExplicitly), len(usages.MatchesGenerically)))
+	return usages, nil
+}
+
+// uses the cache built alongside the other indexes, the DB is only queried if the cache has not been built yet
+func cachedExtraDeckMonsters(ctx context.Context) ([]*ygo.Card, *status.Status) {
+	if cached := extraDeckCards.Load(); cached != nil {
+		return *cached, nil
+	}
+	return cardRepo.GetExtraDeckMonsters(ctx)
+}
+
+func (s *ygoCardServiceServer) GetCardEffectBreakdown(ctx context.Context, req *ygo.ResourceID) (*ygo.CardEffectBreakdown, error) {
+	logger, newCtx := util.NewLogger(ctx, "Card Effect Breakdown", slog.String("card_id", req.ID))
+
+	c, err := cardRepo.GetCardByID(newCtx, req.ID)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	effect, pendulumEffect := model.GetEffectBreakdown(model.YGOCardGRPC{Card: c})
+	breakdown := &ygo.CardEffectBreakdown{Card: c, Effect: model.EffectBreakdownToProto(effect), PendulumEffect: model.EffectBreakdownToProto(pendulumEffect)}
+
+	logger.Info(fmt.Sprintf("Card has %d effect(s) and %d Pendulum effect(s)", len(breakdown.Effect.GetEffects()), len(breakdown.PendulumEffect.GetEffects())))
+	return breakdown, nil
 }
 
 func (s *ygoCardServiceServer) GetArchetypalCardsUsingCardName(ctx context.Context, req *ygo.Archetype) (*ygo.CardList, error) {
@@ -64,9 +260,96 @@ func (s *ygoCardServiceServer) GetExplicitArchetypalExclusions(ctx context.Conte
 	return c, err.Err()
 }
 
+type archetypeQueryResult struct {
+	cards *ygo.CardList
+	err   *status.Status
+}
+
+func (s *ygoCardServiceServer) GetArchetypeMembers(ctx context.Context, req *ygo.Archetype) (*ygo.ArchetypeMembers, error) {
+	logger, newCtx := util.NewLogger(ctx, "Archetype Members", slog.String("archetype", req.Archetype))
+
+	var wg sync.WaitGroup
+	nameMatches := util.NewAtomicWaitGroup[archetypeQueryResult](&wg)
+	inclusions := util.NewAtomicWaitGroup[archetypeQueryResult](&wg)
+	exclusions := util.NewAtomicWaitGroup[archetypeQueryResult](&wg)
+
+	go func() {
+		c, err := cardRepo.GetArchetypalCardsUsingCardName(newCtx, req.Archetype)
+		nameMatches.Store(&archetypeQueryResult{cards: c, err: err})
+	}()
+	go func() {
+		c, err := cardRepo.GetExplicitArchetypalInclusions(newCtx, req.Archetype)
+		inclusions.Store(&archetypeQueryResult{cards: c, err: err})
+	}()
+	go func() {
+		c, err := cardRepo.GetExplicitArchetypalExclusions(newCtx, req.Archetype)
+		exclusions.Store(&archetypeQueryResult{cards: c, err: err})
+	}()
+
+	results := []*archetypeQueryResult{nameMatches.Load(), inclusions.Load(), exclusions.Load()}
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err.Err()
+		}
+	}
+
+	members := mergeArchetypeMembers(results[0].cards.Cards, results[1].cards.Cards, results[2].cards.Cards)
+	logger.Info(fmt.Sprintf("Archetype has %d member(s) and %d exclusion(s)", len(members), len(results[2].cards.Cards)))
+	return &ygo.ArchetypeMembers{Archetype: req.Archetype, Members: members, Excluded: results[2].cards.Cards}, nil
+}
+
+// Members are sorted by name. Excluded cards are never members and name matches take precedence when a card is also explicitly treated as the archetype.
+func mergeArchetypeMembers(nameMatches, inclusions, exclusions []*ygo.Card) []*ygo.ArchetypeMember {
+	excludedIDs := make(map[string]struct{}, len(exclusions))
+	for _, c := range exclusions {
+		excludedIDs[c.ID] = struct{}{}
+	}
+
+	members := make([]*ygo.ArchetypeMember, 0, len(nameMatches)+len(inclusions))
+	memberIDs := make(map[string]struct{}, cap(members))
+	addMembers := func(cards []*ygo.Card, reason ygo.ArchetypeInclusionReason) {
+		for _, c := range cards {
+			_, isExcluded := excludedIDs[c.ID]
+			if _, isMember := memberIDs[c.ID]; !isMember && !isExcluded {
+				memberIDs[c.ID] = struct{}{}
+				members = append(members, &ygo.ArchetypeMember{Card: c, Reason: reason})
+			}
+		}
+	}
+	addMembers(nameMatches, ygo.ArchetypeInclusionReason_NAME_MATCH)
+	addMembers(inclusions, ygo.ArchetypeInclusionReason_ALWAYS_TREATED_AS)
+	slices.SortStableFunc(members, func(a, b *ygo.ArchetypeMember) int { return strings.Compare(a.Card.Name, b.Card.Name) })
+	return members
+}
+
+func (s *ygoCardServiceServer) ListArchetypes(ctx context.Context, req *emptypb.Empty) (*ygo.ArchetypeCatalog, error) {
+	logger, _ := util.NewLogger(ctx, "List Archetypes")
+
+	catalog := archetypeCatalog.Load()
+	if catalog == nil {
+		logger.Warn("Archetype catalog not available")
+		return nil, status.Error(codes.Unavailable, "Archetype catalog is not available yet")
+	}
+	return catalog, nil
+}
+
 func (s *ygoCardServiceServer) GetRandomCard(ctx context.Context, req *ygo.BlackListed) (*ygo.Card, error) {
 	_, newCtx := util.NewLogger(ctx, "Random Card")
 
-	c, err := cardRepo.GetRandomCard(newCtx, req.BlackListedRefs)
+	single := proto.Clone(req).(*ygo.BlackListed)
+	single.Count = 1
+	if c, err := cardRepo.GetRandomCards(newCtx, single); err != nil {
+		return nil, err.Err()
+	} else if len(c.Cards) == 0 {
+		return nil, status.New(codes.NotFound, "No cards match filters").Err()
+	} else {
+		return c.Cards[0], nil
+	}
+}
+
+func (s *ygoCardServiceServer) GetRandomCards(ctx context.Context, req *ygo.BlackListed) (*ygo.RandomCards, error) {
+	_, newCtx := util.NewLogger(ctx, "Random Cards")
+
+	c, err := cardRepo.GetRandomCards(newCtx, req)
 	return c, err.Err()
 }
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/protobuf/proto"
+)
+
+func cardsWithEffectLength(total, effectLength int) []*ygo.Card {
+	cards := make([]*ygo.Card, total)
+	for i := range cards {
+		cards[i] = &ygo.Card{ID: fmt.Sprintf("%08d", i), Name: fmt.Sprintf("Card %d", i), Effect: strings.Repeat("a", effectLength)}
+	}
+	return cards
+}
+
+func TestChunkCardsBySize(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName       string
+		cards          []*ygo.Card
+		maxSize        int
+		expectedChunks int
+	}{
+		{testName: "No cards", cards: []*ygo.Card{}, maxSize: 1 << 10, expectedChunks: 0},
+		{testName: "Page under max size", cards: cardsWithEffectLength(10, 50), maxSize: 1 << 10, expectedChunks: 1},
+		{testName: "Page over max size", cards: cardsWithEffectLength(10, 200), maxSize: 1 << 10, expectedChunks: 3},
+		{testName: "Card over max size", cards: cardsWithEffectLength(2, 2000), maxSize: 1 << 10, expectedChunks: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			chunks := chunkCardsBySize(tt.cards, tt.maxSize)
+			assert.Len(chunks, tt.expectedChunks)
+
+			numCards := 0
+			for _, chunk := range chunks {
+				if len(chunk) > 1 {
+					assert.LessOrEqual(proto.Size(&ygo.CardList{Cards: chunk}), tt.maxSize)
+				}
+				numCards += len(chunk)
+			}
+			assert.Equal(len(tt.cards), numCards, "Every card should be sent in order")
+		})
+	}
+}
+
+func TestMergeArchetypeMembers(t *testing.T) {
+	assert := assert.New(t)
+	card := func(id, name string) *ygo.Card { return &ygo.Card{ID: id, Name: name} }
+
+	type member struct {
+		id     string
+		reason ygo.ArchetypeInclusionReason
+	}
+	tests := []struct {
+		testName    string
+		nameMatches []*ygo.Card
+		inclusions  []*ygo.Card
+		exclusions  []*ygo.Card
+		expected    []member
+	}{
+		{
+			testName:    "Sorted by name",
+			nameMatches: []*ygo.Card{card("3", "Blue-Eyes White Dragon"), card("1", "Blue-Eyes Alternative White Dragon")},
+			inclusions:  []*ygo.Card{card("2", "Azure-Eyes Silver Dragon")},
+			expected: []member{
+				{id: "2", reason: ygo.ArchetypeInclusionReason_ALWAYS_TREATED_AS},
+				{id: "1", reason: ygo.ArchetypeInclusionReason_NAME_MATCH},
+				{id: "3", reason: ygo.ArchetypeInclusionReason_NAME_MATCH},
+			},
+		},
+		{
+			testName:    "Name match takes precedence over explicit inclusion",
+			nameMatches: []*ygo.Card{card("1", "Blue-Eyes White Dragon")},
+			inclusions:  []*ygo.Card{card("1", "Blue-Eyes White Dragon")},
+			expected:    []member{{id: "1", reason: ygo.ArchetypeInclusionReason_NAME_MATCH}},
+		},
+		{
+			testName:    "Exclusions are removed",
+			nameMatches: []*ygo.Card{card("1", "Blue-Eyes White Dragon"), card("4", "Blue-Eyes Jet Dragon")},
+			inclusions:  []*ygo.Card{card("2", "Azure-Eyes Silver Dragon")},
+			exclusions:  []*ygo.Card{card("4", "Blue-Eyes Jet Dragon"), card("2", "Azure-Eyes Silver Dragon")},
+			expected:    []member{{id: "1", reason: ygo.ArchetypeInclusionReason_NAME_MATCH}},
+		},
+		{
+			testName: "No cards",
+			expected: []member{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			members := mergeArchetypeMembers(tt.nameMatches, tt.inclusions, tt.exclusions)
+
+			actual := make([]member, len(members))
+			for i, m := range members {
+				actual[i] = member{id: m.Card.ID, reason: m.Reason}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *ygoCatalogServiceServer) GetCardColorCatalog(ctx context.Context, req *emptypb.Empty) (*ygo.CardColorCatalog, error) {
+	_, newCtx := util.NewLogger(ctx, "Card Color Catalog")
+
+	c, err := catalogRepo.GetCardColorCatalog(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetCardAttributes(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Card Attributes")
+
+	c, err := catalogRepo.GetCardAttributes(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetMonsterTypes(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Monster Types")
+
+	c, err := catalogRepo.GetMonsterTypes(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetMonsterAbilities(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Monster Abilities")
+
+	c, err := catalogRepo.GetMonsterAbilities(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetProductTypes(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Product Types")
+
+	c, err := catalogRepo.GetProductTypes(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetProductSubTypes(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Product Sub Types")
+
+	c, err := catalogRepo.GetProductSubTypes(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetRarities(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Rarities")
+
+	c, err := catalogRepo.GetRarities(newCtx)
+	return c, err.Err()
+}
+
+func (s *ygoCatalogServiceServer) GetLocales(ctx context.Context, req *emptypb.Empty) (*ygo.CatalogEntries, error) {
+	_, newCtx := util.NewLogger(ctx, "Locales")
+
+	c, err := catalogRepo.GetLocales(newCtx)
+	return c, err.Err()
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"github.com/ygo-skc/skc-go/ygo-service/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// in memory CardRepository, methods that are not overridden panic when called
+type fakeCardRepo struct {
+	db.CardRepository
+	cards []*ygo.Card
+}
+
+// replaces cardRepo for the duration of a test
+func useFakeCardRepo(cleanup func(func()), cards ...*ygo.Card) {
+	previous := cardRepo
+	cardRepo = fakeCardRepo{cards: cards}
+	cleanup(func() { cardRepo = previous })
+}
+
+func (r fakeCardRepo) GetCardByID(_ context.Context, cardID string) (*ygo.Card, *status.Status) {
+	if i := slices.IndexFunc(r.cards, func(c *ygo.Card) bool { return c.ID == cardID }); i != -1 {
+		return r.cards[i], nil
+	}
+	return nil, status.New(codes.NotFound, "No results found")
+}
+
+func (r fakeCardRepo) GetCardsByIDs(_ context.Context, cardIDs model.CardIDs) (*ygo.Cards, *status.Status) {
+	cards := make(map[string]*ygo.Card)
+	for _, c := range r.cards {
+		if slices.Contains(cardIDs, c.ID) {
+			cards[c.ID] = c
+		}
+	}
+	return &ygo.Cards{CardInfo: cards, UnknownResources: model.FindMissingKeys(cards, cardIDs)}, nil
+}
+
+func (r fakeCardRepo) GetCardsByNames(_ context.Context, cardNames model.CardNames) (*ygo.Cards, *status.Status) {
+	cards := make(map[string]*ygo.Card)
+	for _, c := range r.cards {
+		if slices.Contains(cardNames, c.Name) {
+			cards[c.Name] = c
+		}
+	}
+	return &ygo.Cards{CardInfo: cards, UnknownResources: model.FindMissingKeys(cards, cardNames)}, nil
+}
+
+// full text search is loose, every card is returned so callers need to confirm the reference
+func (r fakeCardRepo) GetCardsReferencingNameInEffect(_ context.Context, _ []string) (*ygo.CardList, *status.Status) {
+	return &ygo.CardList{Cards: r.cards}, nil
+}
+
+func (r fakeCardRepo) GetRandomCardCandidates(_ context.Context, _ *ygo.BlackListed) ([]string, *status.Status) {
+	ids := make([]string, 0, len(r.cards))
+	for _, c := range r.cards {
+		ids = append(ids, c.ID)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
@@ -27,3 +27,38 @@ func (s *ygoProductServiceServer) GetProductsSummaryByID(ctx context.Context, re
 	products, err := productRepo.GetProductsSummaryByID(newCtx, req.IDs)
 	return products, err.Err()
 }
+
+func (s *ygoProductServiceServer) GetProductsSummaryByName(ctx context.Context, req *ygo.ResourceNames) (*ygo.Products, error) {
+	_, newCtx := util.NewLogger(ctx, "Products Summary By Name")
+
+	products, err := productRepo.GetProductsSummaryByName(newCtx, req.Names)
+	return products, err.Err()
+}
+
+func (s *ygoProductServiceServer) GetCardsByPrintCode(ctx context.Context, req *ygo.ResourceIDs) (*ygo.PrintedCards, error) {
+	_, newCtx := util.NewLogger(ctx, "Cards By Print Code")
+
+	printedCards, err := productRepo.GetCardsByPrintCode(newCtx, req.IDs)
+	return printedCards, err.Err()
+}
+
+func (s *ygoProductServiceServer) GetCardPrintings(ctx context.Context, req *ygo.ResourceID) (*ygo.CardPrintings, error) {
+	_, newCtx := util.NewLogger(ctx, "Card Printings")
+
+	printings, err := productRepo.GetCardPrintings(newCtx, req.ID)
+	return printings, err.Err()
+}
+
+func (s *ygoProductServiceServer) GetCardPrintingsByIDs(ctx context.Context, req *ygo.ResourceIDs) (*ygo.BatchCardPrintings, error) {
+	_, newCtx := util.NewLogger(ctx, "Batch Card Printings")
+
+	printings, err := productRepo.GetCardPrintingsByIDs(newCtx, req.IDs)
+	return printings, err.Err()
+}
+
+func (s *ygoProductServiceServer) SearchProducts(ctx context.Context, req *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, error) {
+	_, newCtx := util.NewLogger(ctx, "Search Products")
+
+	results, err := productRepo.SearchProducts(newCtx, req)
+	return results, err.Err()
+}
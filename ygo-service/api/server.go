@@ -1,19 +1,25 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/ygo-skc/skc-go/common/v2/health"
+	"github.com/ygo-skc/skc-go/common/v2/model"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 	"github.com/ygo-skc/skc-go/ygo-service/db"
+	"github.com/ygo-skc/skc-go/ygo-service/index"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -33,10 +39,23 @@ var (
 	productRepo         db.ProductRepository         = db.YGOProductRepository{}
 	cardRestrictionRepo db.CardRestrictionRepository = db.YGOCardRestrictionRepository{}
 	scoreRepo           db.ScoreRepository           = db.YGOScoreRepository{}
+	catalogRepo         db.CatalogRepository         = db.YGOCatalogRepository{}
+)
+
+var (
+	cardNameIndex    atomic.Pointer[index.CardNameIndex]
+	archetypeCatalog atomic.Pointer[ygo.ArchetypeCatalog]
+	similarCardIndex atomic.Pointer[index.SimilarCardIndex]
+	extraDeckCards   atomic.Pointer[[]*ygo.Card] // extra deck monsters along with their parsed materials
 )
 
 const (
-	port = 9020
+	port                 = 9020
+	indexRefreshInterval = 6 * time.Hour
+
+	maxSendMsgSize = 2 << 20
+	// most cards DB is paged through at once - a page of cards with long effects approaches the max send size, streams split pages that go over it
+	cardPageSize = 500
 )
 
 type healthServiceServer struct {
@@ -59,7 +78,77 @@ type ygoScoreServiceServer struct {
 	ygo.ScoreServiceServer
 }
 
+type ygoCatalogServiceServer struct {
+	ygo.CatalogServiceServer
+}
+
+// in memory indexes are built in the background so the server can start accepting requests right away. Until an index is built (or if it cannot be built),
+// features relying on it return empty results, report they are unavailable or fall back to the DB. Previously built indexes are kept if a refresh fails.
+func loadIndexes() {
+	logger, ctx := util.NewLogger(context.Background(), "Load Indexes")
+
+	if cardNames, err := cardRepo.GetCardNames(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Could not build card name index - %s", err.Message()))
+	} else {
+		cardNameIndex.Store(index.NewCardNameIndex(cardNames))
+		logger.Info("Card name index built", slog.Int("size", len(cardNames)))
+	}
+
+	catalogBuilder, similarCardIndexBuilder := index.NewArchetypeCatalogBuilder(), index.NewSimilarCardIndexBuilder()
+	extraDeck := make([]*ygo.Card, 0, 4000)
+	if err := forEachCard(ctx, func(c *ygo.Card) {
+		catalogBuilder.Add(c)
+		similarCardIndexBuilder.Add(c)
+		if model.IsExtraDeckMonster(model.YGOCardGRPC{Card: c}) {
+			extraDeck = append(extraDeck, c)
+		}
+	}); err != nil {
+		logger.Error(fmt.Sprintf("Could not build archetype catalog, similar card index and extra deck cache - %s", err.Message()))
+	} else {
+		catalog := catalogBuilder.Build(time.Now().In(chicagoLocation))
+		archetypeCatalog.Store(catalog)
+		logger.Info("Archetype catalog built", slog.Int("size", len(catalog.Archetypes)))
+
+		similarCards := similarCardIndexBuilder.Build()
+		similarCardIndex.Store(similarCards)
+		logger.Info("Similar card index built", slog.Int("size", similarCards.Size()))
+
+		extraDeckCards.Store(&extraDeck)
+		logger.Info("Extra deck cache built", slog.Int("size", len(extraDeck)))
+	}
+}
+
+// pages through all cards so only the data needed by the indexes is kept in memory
+func forEachCard(ctx context.Context, visit func(*ygo.Card)) *status.Status {
+	lastID := ""
+	for {
+		cards, err := cardRepo.GetCardsAfterID(ctx, lastID, time.Time{}, cardPageSize)
+		if err != nil {
+			return err
+		}
+		for _, c := range cards {
+			visit(c)
+		}
+		if len(cards) < cardPageSize {
+			return nil
+		}
+		lastID = cards[len(cards)-1].ID
+	}
+}
+
+func refreshIndexes() {
+	loadIndexes()
+
+	ticker := time.NewTicker(indexRefreshInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		loadIndexes()
+	}
+}
+
 func RunService() {
+	go refreshIndexes()
+
 	util.CombineCerts("certs")
 	if creds, err := credentials.NewServerTLSFromFile("certs/concatenated.crt", "certs/private.key"); err != nil {
 		log.Fatalf("Unable to create TLS credentials: %v", err)
@@ -90,7 +179,7 @@ func RunService() {
 			grpc.SharedWriteBuffer(true),
 
 			grpc.MaxRecvMsgSize(200<<10),
-			grpc.MaxSendMsgSize(2<<20),
+			grpc.MaxSendMsgSize(maxSendMsgSize),
 		)
 
 		health.RegisterHealthServiceServer(grpcServer, &healthServiceServer{})
@@ -98,6 +187,7 @@ func RunService() {
 		ygo.RegisterProductServiceServer(grpcServer, &ygoProductServiceServer{})
 		ygo.RegisterCardRestrictionServiceServer(grpcServer, &ygoCardRestrictionServiceServer{})
 		ygo.RegisterScoreServiceServer(grpcServer, &ygoScoreServiceServer{})
+		ygo.RegisterCatalogServiceServer(grpcServer, &ygoCatalogServiceServer{})
 
 		log.Printf("Starting gRPC service on port %d...", port)
 		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSimilarCardsLimit = 10
+	maxSimilarCardsLimit     = 50
+)
+
+// Cards added since the index was last built are not part of the index, they have no similar cards until the next refresh.
+func (s *ygoCardServiceServer) GetSimilarCards(ctx context.Context, req *ygo.SimilarCardsRequest) (*ygo.SimilarCards, error) {
+	limit := defaultSimilarCardsLimit
+	if req.Limit != 0 {
+		limit = min(int(req.Limit), maxSimilarCardsLimit)
+	}
+	logger, newCtx := util.NewLogger(ctx, "Similar Cards", slog.String("card_id", req.ID), slog.Int("limit", limit))
+
+	idx := similarCardIndex.Load()
+	if idx == nil {
+		logger.Warn("Similar card index not available")
+		return nil, status.Error(codes.Unavailable, "Similar card index is not available yet")
+	}
+
+	c, err := cardRepo.GetCardByID(newCtx, req.ID)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	similarCards := &ygo.SimilarCards{Card: c, Similar: []*ygo.SimilarCard{}}
+	matches, exists := idx.Similar(req.ID, limit)
+	if !exists || len(matches) == 0 {
+		logger.Info("Card has no similar cards")
+		return similarCards, nil
+	}
+
+	ids := make(model.CardIDs, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	cards, err := cardRepo.GetCardsByIDs(newCtx, ids)
+	if err != nil {
+		return nil, err.Err()
+	}
+
+	// cards removed since the index was built are skipped
+	for _, m := range matches {
+		if card, exists := cards.CardInfo[m.ID]; exists {
+			similarCards.Similar = append(similarCards.Similar, &ygo.SimilarCard{Card: card, Score: m.Score})
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Found %d similar card(s)", len(similarCards.Similar)))
+	return similarCards, nil
+}
@@ -5,13 +5,19 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/parser"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -36,6 +42,13 @@ FROM
 ORDER BY
 	color_id`
 
+	cardNamesQuery = `
+SELECT
+	card_number,
+	card_name
+FROM
+	card_info`
+
 	cardByCardIDQuery = `
 SELECT
 	%s
@@ -51,6 +64,74 @@ FROM
 WHERE
 	card_number IN (%s)`
 
+	searchCardsQuery = `
+SELECT
+	%s
+FROM
+	card_info
+WHERE
+	%s
+ORDER BY
+	%s
+LIMIT
+	?`
+	searchCardsCursorCondition = `(%s) > (SELECT %s FROM card_info WHERE card_number = ?)`
+	cardExistsQuery            = `SELECT EXISTS(SELECT 1 FROM card_info WHERE card_number = ?)`
+
+	searchCardTextQuery = `
+SELECT
+	%s,
+	%s AS score
+FROM
+	card_info
+WHERE
+	%s
+ORDER BY
+	score DESC,
+	card_number
+LIMIT
+	?
+OFFSET
+	?`
+	effectTextCondition = "MATCH (card_effect) AGAINST (? IN BOOLEAN MODE)"
+	nameTextCondition   = "card_name LIKE ?"
+
+	cardsAfterIDQuery = `
+SELECT
+	%s
+FROM
+	card_info
+WHERE
+	card_number > ?
+ORDER BY
+	card_number
+LIMIT
+	?`
+	// last_modified is added by migrations/001_card_info_last_modified.sql
+	cardsAfterIDModifiedSinceQuery = `
+SELECT
+	%s
+FROM
+	card_info
+WHERE
+	card_number > ?
+	AND last_modified >= ?
+ORDER BY
+	card_number
+LIMIT
+	?`
+
+	extraDeckMonstersQuery = `
+SELECT
+	%s
+FROM
+	card_info
+WHERE
+	card_color REGEXP 'Fusion|Synchro|Xyz|Link'
+ORDER BY
+	color_id,
+	card_name`
+
 	cardsByCardNamesQuery = `
 SELECT
 	%s
@@ -75,14 +156,14 @@ SELECT
 FROM
 	card_info
 WHERE
-	MATCH (card_effect) AGAINST ('+"This card is always treated as" +"%s"' IN BOOLEAN MODE)`
+	MATCH (card_effect) AGAINST (? IN BOOLEAN MODE)`
 	archetypeExclusionSubQuery = `
 SELECT
 	%s
 FROM
 	card_info
 WHERE
-	MATCH (card_effect) AGAINST ('+"This card is not treated as" +"%s"' IN BOOLEAN MODE)`
+	MATCH (card_effect) AGAINST (? IN BOOLEAN MODE)`
 
 	archetypalCardsUsingCardNameQuery = `
 SELECT
@@ -99,7 +180,7 @@ SELECT
 FROM
 	(%s) a
 WHERE
-	a.card_effect REGEXP 'always treated as a.*"%s".* card'
+	a.card_effect REGEXP ?
 ORDER BY
 	card_name`
 	nonArchetypalCardsUsingCardTextQuery = `
@@ -108,33 +189,33 @@ SELECT
 FROM
 	(%s) a
 WHERE
-	a.card_effect REGEXP 'not treated as.*"%s".* card'
+	a.card_effect REGEXP ?
 ORDER BY
 	card_name`
 
-	randomCardQuery = `
+	randomCardCandidatesQuery = `
 SELECT
-	%s
+	card_number
 FROM
 	card_info
 WHERE
 	card_color != 'Token'
+	AND %s
 ORDER BY
-	RAND()
-LIMIT
-	1`
-	randomCardWithBlackListQuery = `
+	card_number`
+	// rows are ranked by a hash of the seed and card ID - same seed returns the same cards no matter the order rows are scanned in
+	randomCardsQuery = `
 SELECT
 	%s
 FROM
 	card_info
 WHERE
-	card_number NOT IN (%s)
-	AND card_color != 'Token'
+	card_color != 'Token'
+	AND %s
+	AND card_number %s ?
 ORDER BY
-	RAND()
-LIMIT
-	1`
+	card_number
+LIMIT ?`
 )
 
 func queryCard(logger *slog.Logger, query string, args []any) (*ygo.Card, *status.Status) {
@@ -148,15 +229,24 @@ func queryCard(logger *slog.Logger, query string, args []any) (*ygo.Card, *statu
 		return nil, handleQueryError(logger, err)
 	}
 
-	card := model.NewYGOCardProtoBuilder(id, name).
+	return buildCard(id, color, name, attribute, effect, monsterType, atk, def), nil
+}
+
+// builds card using DB columns and derives any data that is computed from card text
+func buildCard(id, color, name, attribute, effect string, monsterType *string, atk, def *uint32) *ygo.Card {
+	return model.NewYGOCardProtoBuilder(id, name).
 		WithColor(color).
 		WithAttribute(attribute).
 		WithEffect(effect).
 		WithMonsterType(monsterType).
 		WithAttack(atk).
 		WithDefense(def).
+		WithClassification().
+		WithEffectTags().
+		WithParsedMonsterType().
+		WithParsedMaterials().
+		WithParsedPendulumEffect().
 		Build()
-	return card, nil
 }
 
 func parseCardRows[T []*ygo.Card | map[string]*ygo.Card](ctx context.Context, rows *sql.Rows, dataStructure *T, collector func(*T, *ygo.Card)) *status.Status {
@@ -169,16 +259,7 @@ func parseCardRows[T []*ygo.Card | map[string]*ygo.Card](ctx context.Context, ro
 		if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def); err != nil {
 			return handleRowParsingError(util.RetrieveLogger(ctx), err)
 		} else {
-			collector(
-				dataStructure,
-				model.NewYGOCardProtoBuilder(id, name).
-					WithColor(color).
-					WithAttribute(attribute).
-					WithEffect(effect).
-					WithMonsterType(monsterType).
-					WithAttack(atk).
-					WithDefense(def).
-					Build())
+			collector(dataStructure, buildCard(id, color, name, attribute, effect, monsterType, atk, def))
 		}
 	}
 
@@ -197,20 +278,219 @@ func collectWithMapUsingNameKey(cards *map[string]*ygo.Card, card *ygo.Card) {
 	(*cards)[model.CardNameAsKey(card)] = card
 }
 
+const (
+	defaultCardSearchPageSize = 50
+	maxCardSearchPageSize     = 200
+	maxTaggedSearchBatches    = 10 // rare tags could otherwise scan most of card_info in a single request
+
+	defaultRandomCardCount = 1
+	maxRandomCardCount     = 50
+	cardIDKeyspace         = 100_000_000 // card IDs (passcodes) are 8 digits
+)
+
+func buildCardSearchConditions(filter *ygo.CardSearchFilter) ([]string, []any) {
+	conditions := make([]string, 0, 6)
+	args := make([]any, 0, 10)
+
+	if len(filter.GetColors()) > 0 {
+		conditions = append(conditions, fmt.Sprintf("card_color IN (%s)", variablePlaceholders(len(filter.Colors))))
+		colorArgs, _ := buildVariableQuerySubjects(filter.Colors)
+		args = append(args, colorArgs...)
+	}
+	if len(filter.GetAttributes()) > 0 {
+		conditions = append(conditions, fmt.Sprintf("card_attribute IN (%s)", variablePlaceholders(len(filter.Attributes))))
+		attributeArgs, _ := buildVariableQuerySubjects(filter.Attributes)
+		args = append(args, attributeArgs...)
+	}
+	// monster type is stored as a slash delimited string (Spellcaster/Tuner/Effect), each requested type needs to match a whole token
+	for _, monsterType := range filter.GetMonsterTypes() {
+		conditions = append(conditions, `CONCAT('/', monster_type, '/') LIKE ?`)
+		args = append(args, "%/"+escapeLike(monsterType)+"/%")
+	}
+	if monsterTypeConditions, monsterTypeArgs := monsterTypeFilterConditions(filter.GetMonsterType()); len(monsterTypeConditions) > 0 {
+		conditions = append(conditions, monsterTypeConditions...)
+		args = append(args, monsterTypeArgs...)
+	}
+	if condition, statArgs := statRangeCondition("monster_attack", filter.GetAttack()); condition != "" {
+		conditions = append(conditions, condition)
+		args = append(args, statArgs...)
+	}
+	if condition, statArgs := statRangeCondition("monster_defense", filter.GetDefense()); condition != "" {
+		conditions = append(conditions, condition)
+		args = append(args, statArgs...)
+	}
+	if name := filter.GetName(); name != "" {
+		conditions = append(conditions, "card_name LIKE ?")
+		args = append(args, "%"+escapeLike(name)+"%")
+	}
+	for _, tag := range filter.GetTags() {
+		if pattern, exists := parser.EffectTagPattern(parser.EffectTag(tag)); exists {
+			conditions = append(conditions, "card_effect REGEXP ?")
+			args = append(args, "(?i)"+pattern)
+		}
+	}
+
+	return conditions, args
+}
+
+// Type is the first token of monster_type while every flag is a token that can appear anywhere after it.
+// NOT LIKE against a null monster_type is also null so Spells and Traps are never part of the results.
+func monsterTypeFilterConditions(f *ygo.MonsterTypeFilter) ([]string, []any) {
+	if f == nil {
+		return nil, nil
+	}
+
+	conditions := make([]string, 0, 2)
+	args := make([]any, 0, 2)
+	if f.Type != "" {
+		conditions = append(conditions, `CONCAT(monster_type, '/') LIKE ?`)
+		args = append(args, escapeLike(f.Type)+"/%")
+	}
+
+	flags := []struct {
+		token string
+		value *wrapperspb.BoolValue
+	}{
+		{"Tuner", f.Tuner}, {"Flip", f.Flip}, {"Gemini", f.Gemini}, {"Spirit", f.Spirit}, {"Union", f.Union}, {"Toon", f.Toon},
+		{"Normal", f.Normal}, {"Effect", f.Effect}, {"Ritual", f.Ritual}, {"Fusion", f.Fusion}, {"Synchro", f.Synchro},
+		{"Xyz", f.Xyz}, {"Pendulum", f.Pendulum}, {"Link", f.Link},
+	}
+	for _, flag := range flags {
+		if flag.value == nil {
+			continue
+		}
+
+		if flag.value.Value {
+			conditions = append(conditions, `CONCAT('/', monster_type, '/') LIKE ?`)
+		} else {
+			conditions = append(conditions, `CONCAT('/', monster_type, '/') NOT LIKE ?`)
+		}
+		args = append(args, "%/"+flag.token+"/%")
+	}
+	return conditions, args
+}
+
+// ATK/DEF of "?" is stored as null. Spells and Traps also have null stats so monster_type is used to only consider monsters.
+func statRangeCondition(column string, r *ygo.StatRange) (string, []any) {
+	if r == nil || (r.Min == nil && r.Max == nil && !r.IncludeUnknown) {
+		return "", nil
+	}
+
+	bounds := make([]string, 0, 2)
+	args := make([]any, 0, 2)
+	if r.Min != nil {
+		bounds = append(bounds, fmt.Sprintf("%s >= ?", column))
+		args = append(args, r.Min.Value)
+	}
+	if r.Max != nil {
+		bounds = append(bounds, fmt.Sprintf("%s <= ?", column))
+		args = append(args, r.Max.Value)
+	}
+
+	unknown := fmt.Sprintf("(%s IS NULL AND monster_type IS NOT NULL)", column)
+	switch {
+	case len(bounds) == 0:
+		return unknown, args
+	case r.IncludeUnknown:
+		return fmt.Sprintf("((%s) OR %s)", strings.Join(bounds, " AND "), unknown), args
+	default:
+		return fmt.Sprintf("(%s)", strings.Join(bounds, " AND ")), args
+	}
+}
+
+// Each term is matched using its own MATCH/LIKE so the boolean structure of the query is kept by SQL instead of relying on boolean mode operators.
+// User input is only ever bound as an argument.
+func buildCardTextCondition(n *parser.QueryNode) (string, []any) {
+	switch n.Type {
+	case parser.TermNode:
+		return cardTextTermCondition(n)
+	case parser.NotNode:
+		condition, args := buildCardTextCondition(n.Children[0])
+		return "NOT " + condition, args
+	default:
+		operator := " AND "
+		if n.Type == parser.OrNode {
+			operator = " OR "
+		}
+
+		conditions := make([]string, len(n.Children))
+		args := make([]any, 0, len(n.Children))
+		for i, child := range n.Children {
+			condition, childArgs := buildCardTextCondition(child)
+			conditions[i] = condition
+			args = append(args, childArgs...)
+		}
+		return "(" + strings.Join(conditions, operator) + ")", args
+	}
+}
+
+// only card_effect has a full text index, names use LIKE instead
+func cardTextTermCondition(term *parser.QueryNode) (string, []any) {
+	if term.Field == parser.NameField {
+		return nameTextCondition, []any{"%" + escapeLike(term.Text) + "%"}
+	}
+	return effectTextCondition, []any{term.BooleanModeTerm()}
+}
+
+// Full text relevance of every term that is not negated. Name matches are worth a point each.
+func cardTextRank(q *parser.QueryNode) (string, []any) {
+	terms := q.PositiveTerms()
+	scores := make([]string, len(terms))
+	args := make([]any, 0, len(terms))
+	for i, term := range terms {
+		condition, termArgs := cardTextTermCondition(term)
+		scores[i] = "(" + condition + ")"
+		args = append(args, termArgs...)
+	}
+	return strings.Join(scores, " + "), args
+}
+
+// All keys are ascending so a row constructor comparison can be used to find the next page. Descending stats are negated to achieve this.
+func cardSortKeys(sortOrder ygo.CardSortOrder) string {
+	switch sortOrder {
+	case ygo.CardSortOrder_COLOR_ASC_NAME_ASC:
+		return "color_id, card_name, card_number"
+	case ygo.CardSortOrder_ATTACK_DESC_NAME_ASC:
+		return "-CAST(COALESCE(monster_attack, -1) AS SIGNED), card_name, card_number"
+	case ygo.CardSortOrder_DEFENSE_DESC_NAME_ASC:
+		return "-CAST(COALESCE(monster_defense, -1) AS SIGNED), card_name, card_number"
+	default:
+		return "card_name, card_number"
+	}
+}
+
+func cardSearchPageSize(requested uint32) int {
+	switch {
+	case requested == 0:
+		return defaultCardSearchPageSize
+	case requested > maxCardSearchPageSize:
+		return maxCardSearchPageSize
+	default:
+		return int(requested)
+	}
+}
+
 type CardRepository interface {
 	GetCardColorIDs(context.Context) (*ygo.CardColors, *status.Status)
 
+	GetCardNames(context.Context) (map[string]string, *status.Status)
+
 	GetCardByID(context.Context, string) (*ygo.Card, *status.Status)
 	GetCardsByIDs(context.Context, model.CardIDs) (*ygo.Cards, *status.Status)
+	SearchCards(context.Context, *ygo.CardSearchRequest) (*ygo.CardSearchResults, *status.Status)
+	SearchCardText(context.Context, *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, *status.Status)
+	GetCardsAfterID(context.Context, string, time.Time, int) ([]*ygo.Card, *status.Status)
 
 	GetCardsByNames(context.Context, model.CardNames) (*ygo.Cards, *status.Status)
 	GetCardsReferencingNameInEffect(context.Context, []string) (*ygo.CardList, *status.Status)
+	GetExtraDeckMonsters(context.Context) ([]*ygo.Card, *status.Status)
 
 	GetArchetypalCardsUsingCardName(context.Context, string) (*ygo.CardList, *status.Status)
 	GetExplicitArchetypalInclusions(context.Context, string) (*ygo.CardList, *status.Status)
 	GetExplicitArchetypalExclusions(context.Context, string) (*ygo.CardList, *status.Status)
 
-	GetRandomCard(context.Context, []string) (*ygo.Card, *status.Status)
+	GetRandomCards(context.Context, *ygo.BlackListed) (*ygo.RandomCards, *status.Status)
+	GetRandomCardCandidates(context.Context, *ygo.BlackListed) ([]string, *status.Status)
 }
 type YGOCardRepository struct{}
 
@@ -239,6 +519,30 @@ func (imp YGOCardRepository) GetCardColorIDs(ctx context.Context) (*ygo.CardColo
 	}
 }
 
+// Get name of every card in database keyed by card ID.
+func (imp YGOCardRepository) GetCardNames(ctx context.Context) (map[string]string, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving all card names")
+
+	if rows, err := skcDBConn.Query(cardNamesQuery); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		cardNames := make(map[string]string, 15000)
+		for rows.Next() {
+			var id, name string
+
+			if err := rows.Scan(&id, &name); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+
+			cardNames[id] = name
+		}
+
+		logger.Info(fmt.Sprintf("Retrieved %d card names", len(cardNames)))
+		return cardNames, nil
+	}
+}
+
 func (imp YGOCardRepository) GetCardByID(ctx context.Context, cardID string) (*ygo.Card, *status.Status) {
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Retrieving card data using ID %v", cardID))
@@ -276,6 +580,189 @@ func (imp YGOCardRepository) GetCardsByIDs(ctx context.Context, cardIDs model.Ca
 	}
 }
 
+// Finds cards matching all criteria in the filter. Results are paginated using the ID of the last card of the previous page as the cursor.
+// Tags cannot be fully expressed in SQL, rows are pre-filtered using a looser pattern and then checked against the tags of each card.
+// More rows are fetched until the page is full, every row has been checked or the batch limit is reached - the last case can return a partial page with a cursor.
+func (imp YGOCardRepository) SearchCards(ctx context.Context, req *ygo.CardSearchRequest) (*ygo.CardSearchResults, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching cards using filter %v and sort order %s", req.Filter, req.SortOrder))
+
+	for _, tag := range req.Filter.GetTags() {
+		if _, exists := parser.EffectTagPattern(parser.EffectTag(tag)); !exists {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown effect tag %d", tag))
+		}
+	}
+
+	baseConditions, baseArgs := buildCardSearchConditions(req.Filter)
+	sortKeys := cardSortKeys(req.SortOrder)
+
+	lastCardID := ""
+	if req.Cursor != "" {
+		if decoded, err := validateCursor(logger, cardExistsQuery, req.Cursor); err != nil {
+			return nil, err
+		} else {
+			lastCardID = decoded
+		}
+	}
+
+	pageSize := cardSearchPageSize(req.PageSize)
+	cards, nextCardID, err := collectTaggedCards(lastCardID, pageSize, req.Filter.GetTags(), func(lastCardID string) ([]*ygo.Card, *status.Status) {
+		conditions, args := slices.Clone(baseConditions), slices.Clone(baseArgs)
+		if lastCardID != "" {
+			conditions = append(conditions, fmt.Sprintf(searchCardsCursorCondition, sortKeys, sortKeys))
+			args = append(args, lastCardID)
+		}
+		args = append(args, pageSize+1) // fetch one extra row to determine if there is another page
+
+		query := fmt.Sprintf(searchCardsQuery, cardAttributes, joinConditions(conditions), sortKeys)
+		rows, err := skcDBConn.Query(query, args...)
+		if err != nil {
+			return nil, handleQueryError(logger, err)
+		}
+		defer rows.Close()
+
+		batch := make([]*ygo.Card, 0, pageSize+1)
+		if err := parseCardRows(ctx, rows, &batch, collectWithList); err != nil {
+			return nil, err
+		}
+		return batch, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := &ygo.CardSearchResults{Cards: cards}
+	if nextCardID != "" {
+		results.NextCursor = encodeCursor(nextCardID)
+	}
+
+	logger.Info(fmt.Sprintf("Search returned %d card(s)", len(results.Cards)))
+	return results, nil
+}
+
+// Fetches batches of up to pageSize+1 cards following lastCardID until more than pageSize cards have every tag, there are no more cards or maxTaggedSearchBatches batches were checked.
+// Returns at most pageSize cards along with the ID the next page starts after, which is empty if there are no more cards. When the batch limit is reached the page
+// can be partial (or empty) and the next page starts after the last card checked.
+func collectTaggedCards(lastCardID string, pageSize int, tags []ygo.EffectTag, fetch func(lastCardID string) ([]*ygo.Card, *status.Status)) ([]*ygo.Card, string, *status.Status) {
+	cards := make([]*ygo.Card, 0, pageSize+1)
+	for numBatches := 1; ; numBatches++ {
+		batch, err := fetch(lastCardID)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, c := range batch {
+			if cardHasTags(c, tags) {
+				cards = append(cards, c)
+			}
+		}
+
+		switch {
+		case len(cards) > pageSize:
+			return cards[:pageSize], cards[pageSize-1].ID, nil
+		case len(batch) <= pageSize:
+			return cards, "", nil
+		case numBatches == maxTaggedSearchBatches:
+			return cards, batch[len(batch)-1].ID, nil
+		}
+		lastCardID = batch[len(batch)-1].ID
+	}
+}
+
+func cardHasTags(c *ygo.Card, tags []ygo.EffectTag) bool {
+	for _, tag := range tags {
+		if !slices.ContainsFunc(c.Tags, func(t *ygo.EffectTagMatch) bool { return t.Tag == tag }) {
+			return false
+		}
+	}
+	return true
+}
+
+// Results are ranked by relevance, cursor wraps the offset of the next page as relevance cannot be used as a stable key.
+func (imp YGOCardRepository) SearchCardText(ctx context.Context, req *ygo.CardTextSearchRequest) (*ygo.CardTextSearchResults, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching card text using query %s", req.Query))
+
+	q, err := parser.ParseQuery(req.Query)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Could not parse query %s - %v", req.Query, err))
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid query - %v", err))
+	}
+
+	offset := 0
+	if req.Cursor != "" {
+		if decoded, err := decodeCursor(req.Cursor); err != nil {
+			return nil, status.New(codes.InvalidArgument, "Invalid cursor")
+		} else if offset, err = strconv.Atoi(decoded); err != nil || offset < 0 {
+			return nil, status.New(codes.InvalidArgument, "Invalid cursor")
+		}
+	}
+
+	rank, args := cardTextRank(q)
+	condition, conditionArgs := buildCardTextCondition(q)
+	pageSize := cardSearchPageSize(req.PageSize)
+	args = append(append(args, conditionArgs...), pageSize+1, offset) // fetch one extra row to determine if there is another page
+
+	query := fmt.Sprintf(searchCardTextQuery, cardAttributes, rank, condition)
+	if rows, err := skcDBConn.Query(query, args...); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		defer rows.Close()
+		matches := make([]*ygo.CardTextMatch, 0, pageSize+1)
+		var (
+			id, color, name, attribute, effect string
+			monsterType                        *string
+			atk, def                           *uint32
+			score                              float32
+		)
+		for rows.Next() {
+			if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def, &score); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+			match := &ygo.CardTextMatch{Card: buildCard(id, color, name, attribute, effect, monsterType, atk, def), Score: score}
+			if req.IncludeHighlights {
+				match.Highlights = model.TextSpansToProto(q.EffectSpans(effect))
+			}
+			matches = append(matches, match)
+		}
+
+		results := &ygo.CardTextSearchResults{Matches: matches}
+		if len(matches) > pageSize {
+			results.Matches = matches[:pageSize]
+			results.NextCursor = encodeCursor(strconv.Itoa(offset + pageSize))
+		}
+
+		logger.Info(fmt.Sprintf("Text search returned %d card(s)", len(results.Matches)))
+		return results, nil
+	}
+}
+
+// Retrieves the next batch of cards ordered by ID. Used to walk the entire card table without holding a single long running query open.
+func (imp YGOCardRepository) GetCardsAfterID(ctx context.Context, lastCardID string, modifiedSince time.Time, limit int) ([]*ygo.Card, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving up to %d cards after ID %s modified since %v", limit, lastCardID, modifiedSince))
+
+	// pick correct query based on whether client only wants recently modified cards
+	var query string
+	var args []any
+	if modifiedSince.IsZero() {
+		query = fmt.Sprintf(cardsAfterIDQuery, cardAttributes)
+		args = []any{lastCardID, limit}
+	} else {
+		query = fmt.Sprintf(cardsAfterIDModifiedSinceQuery, cardAttributes)
+		args = []any{lastCardID, modifiedSince, limit}
+	}
+
+	if rows, err := skcDBConn.Query(query, args...); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		cards := make([]*ygo.Card, 0, limit)
+		if err := parseCardRows(ctx, rows, &cards, collectWithList); err != nil {
+			return nil, err
+		}
+		return cards, nil
+	}
+}
+
 // Uses card names to find instance of card
 func (imp YGOCardRepository) GetCardsByNames(ctx context.Context, cardNames model.CardNames) (*ygo.Cards, *status.Status) {
 	logger := util.RetrieveLogger(ctx)
@@ -327,10 +814,30 @@ func (imp YGOCardRepository) GetCardsReferencingNameInEffect(ctx context.Context
 	}
 }
 
+// Retrieves every Fusion, Synchro, Xyz and Link monster (including Pendulum variants) along with their parsed materials.
+func (imp YGOCardRepository) GetExtraDeckMonsters(ctx context.Context) ([]*ygo.Card, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving all extra deck monsters")
+
+	query := fmt.Sprintf(extraDeckMonstersQuery, cardAttributes)
+	if rows, err := skcDBConn.Query(query); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		cards := make([]*ygo.Card, 0, 4000)
+		if err := parseCardRows(ctx, rows, &cards, collectWithList); err != nil {
+			return nil, err
+		}
+		return cards, nil
+	}
+}
+
 func (imp YGOCardRepository) GetArchetypalCardsUsingCardName(ctx context.Context, archetypeName string) (*ygo.CardList, *status.Status) {
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Retrieving card data from DB for all cards that reference archetype %s in their name", archetypeName))
-	searchTerm := `%` + archetypeName + `%`
+	if err := validateArchetype(archetypeName); err != nil {
+		return nil, err
+	}
+	searchTerm := `%` + escapeLike(archetypeName) + `%`
 
 	query := fmt.Sprintf(archetypalCardsUsingCardNameQuery, cardAttributes)
 	if rows, err := skcDBConn.Query(query, searchTerm); err != nil {
@@ -349,9 +856,14 @@ func (imp YGOCardRepository) GetExplicitArchetypalInclusions(ctx context.Context
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Retrieving cards that are explicitly considered part of archetype %s", archetypeName))
 
-	subQuery := fmt.Sprintf(archetypeInclusionSubQuery, cardAttributes, archetypeName)
-	query := fmt.Sprintf(archetypalCardsUsingCardTextQuery, subQuery, archetypeName)
-	if rows, err := skcDBConn.Query(query); err != nil {
+	if err := validateArchetype(archetypeName); err != nil {
+		return nil, err
+	}
+
+	subQuery := fmt.Sprintf(archetypeInclusionSubQuery, cardAttributes)
+	query := fmt.Sprintf(archetypalCardsUsingCardTextQuery, subQuery)
+	if rows, err := skcDBConn.Query(query, archetypeFullTextQuery("This card is always treated as", archetypeName),
+		archetypeClauseRegexp("always treated as a.*", archetypeName)); err != nil {
 		return nil, handleQueryError(logger, err)
 	} else {
 		cards := make([]*ygo.Card, 0)
@@ -366,9 +878,14 @@ func (imp YGOCardRepository) GetExplicitArchetypalExclusions(ctx context.Context
 	logger := util.RetrieveLogger(ctx)
 	logger.Info(fmt.Sprintf("Retrieving cards that are explicitly NOT considered part of archetype %s", archetypeName))
 
-	subQuery := fmt.Sprintf(archetypeExclusionSubQuery, cardAttributes, archetypeName)
-	query := fmt.Sprintf(nonArchetypalCardsUsingCardTextQuery, subQuery, archetypeName)
-	if rows, err := skcDBConn.Query(query); err != nil {
+	if err := validateArchetype(archetypeName); err != nil {
+		return nil, err
+	}
+
+	subQuery := fmt.Sprintf(archetypeExclusionSubQuery, cardAttributes)
+	query := fmt.Sprintf(nonArchetypalCardsUsingCardTextQuery, subQuery)
+	if rows, err := skcDBConn.Query(query, archetypeFullTextQuery("This card is not treated as", archetypeName),
+		archetypeClauseRegexp("not treated as.*", archetypeName)); err != nil {
 		return nil, handleQueryError(logger, err)
 	} else {
 		cards := make([]*ygo.Card, 0)
@@ -380,22 +897,88 @@ func (imp YGOCardRepository) GetExplicitArchetypalExclusions(ctx context.Context
 	}
 }
 
-func (imp YGOCardRepository) GetRandomCard(ctx context.Context, blacklistedCards []string) (*ygo.Card, *status.Status) {
+func (imp YGOCardRepository) GetRandomCards(ctx context.Context, req *ygo.BlackListed) (*ygo.RandomCards, *status.Status) {
 	logger := util.RetrieveLogger(ctx)
-	logger.Info(fmt.Sprintf("Retrieving random card from DB. Client has provided %d blacklisted IDs", len(blacklistedCards)))
 
-	// pick correct query based on contents of blacklistedCards
-	numBlackListed := len(blacklistedCards)
-	var query string
-	var args []any
-	if numBlackListed == 0 {
-		query = fmt.Sprintf(randomCardQuery, cardAttributes)
+	count := defaultRandomCardCount
+	if req.Count != 0 {
+		count = min(int(req.Count), maxRandomCardCount)
+	}
+	seed := rand.Uint64()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+	logger.Info(fmt.Sprintf("Retrieving %d random card(s) from DB using seed %d. Client has provided %d blacklisted IDs",
+		count, seed, len(req.BlackListedRefs)))
+
+	// cards are read using the card ID index, starting at an ID derived from the seed and wrapping around to the first ID when there are not enough cards after it
+	startID := randomCardStartID(seed)
+	cards := make([]*ygo.Card, 0, count)
+	for _, wrapAround := range []bool{false, true} {
+		if len(cards) == count {
+			break
+		}
+
+		query, args := buildRandomCardsQuery(req, startID, wrapAround, count-len(cards))
+		if err := queryRandomCards(ctx, query, args, &cards); err != nil {
+			return nil, err
+		}
+	}
+	logger.Info(fmt.Sprintf("%d random card(s) chosen", len(cards)))
+	return &ygo.RandomCards{Cards: cards, Seed: seed}, nil
+}
+
+func queryRandomCards(ctx context.Context, query string, args []any, cards *[]*ygo.Card) *status.Status {
+	if rows, err := skcDBConn.Query(query, args...); err != nil {
+		return handleQueryError(util.RetrieveLogger(ctx), err)
 	} else {
-		args, _ = buildVariableQuerySubjects(blacklistedCards)
-		query = fmt.Sprintf(randomCardWithBlackListQuery, cardAttributes, variablePlaceholders(numBlackListed))
+		defer rows.Close()
+		return parseCardRows(ctx, rows, cards, collectWithList)
 	}
+}
 
-	c, err := queryCard(logger, query, args)
-	logger.Info(fmt.Sprintf("Random card determined to be; ID: %s, Name: %s", c.ID, c.Name))
-	return c, err
+func randomCardStartID(seed uint64) string {
+	return fmt.Sprintf("%08d", seed%cardIDKeyspace)
+}
+
+// Cards with an ID at or after startID, or cards before startID once the query wraps around.
+func buildRandomCardsQuery(req *ygo.BlackListed, startID string, wrapAround bool, count int) (string, []any) {
+	conditions, args := randomCardConditions(req)
+	operator := ">="
+	if wrapAround {
+		operator = "<"
+	}
+	return fmt.Sprintf(randomCardsQuery, cardAttributes, joinConditions(conditions), operator), append(args, startID, count)
+}
+
+func randomCardConditions(req *ygo.BlackListed) ([]string, []any) {
+	conditions, args := buildCardSearchConditions(&ygo.CardSearchFilter{Colors: req.Colors, Attributes: req.Attributes, MonsterTypes: req.MonsterTypes})
+	if numBlackListed := len(req.BlackListedRefs); numBlackListed != 0 {
+		conditions = append(conditions, fmt.Sprintf("card_number NOT IN (%s)", variablePlaceholders(numBlackListed)))
+		blackListArgs, _ := buildVariableQuerySubjects(req.BlackListedRefs)
+		args = append(args, blackListArgs...)
+	}
+	return conditions, args
+}
+
+// IDs of cards matching filters of req sorted by ID. Token cards are never candidates.
+func (imp YGOCardRepository) GetRandomCardCandidates(ctx context.Context, req *ygo.BlackListed) ([]string, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+
+	conditions, args := randomCardConditions(req)
+
+	candidates := make([]string, 0)
+	if rows, err := skcDBConn.Query(fmt.Sprintf(randomCardCandidatesQuery, joinConditions(conditions)), args...); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		defer rows.Close()
+		var id string
+		for rows.Next() {
+			if err := rows.Scan(&id); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+			candidates = append(candidates, id)
+		}
+	}
+	return candidates, nil
 }
@@ -0,0 +1,317 @@
+package db
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/parser"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBuildRandomCardsQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName           string
+		req                *ygo.BlackListed
+		wrapAround         bool
+		expectedConditions []string
+		expectedArgs       []any
+	}{
+		{testName: "No filters", req: &ygo.BlackListed{}, expectedConditions: []string{"TRUE", "card_number >= ?"}, expectedArgs: []any{"00000042", 3}},
+		{testName: "Wrap around", req: &ygo.BlackListed{}, wrapAround: true, expectedConditions: []string{"TRUE", "card_number < ?"}, expectedArgs: []any{"00000042", 3}},
+		{
+			testName:           "Filters and black list",
+			req:                &ygo.BlackListed{Colors: []string{"Effect"}, MonsterTypes: []string{"Tuner"}, BlackListedRefs: []string{"10000", "20000"}},
+			expectedConditions: []string{"card_color IN (?)", `CONCAT('/', monster_type, '/') LIKE ?`, "card_number NOT IN (?, ?)", "card_number >= ?"},
+			expectedArgs:       []any{"Effect", "%/Tuner/%", "10000", "20000", "00000042", 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			query, args := buildRandomCardsQuery(tt.req, randomCardStartID(42), tt.wrapAround, 3)
+			for _, condition := range tt.expectedConditions {
+				assert.Contains(query, condition)
+			}
+			assert.Contains(query, "card_color != 'Token'", "Tokens are never random cards")
+			assert.Contains(query, "ORDER BY\n\tcard_number\nLIMIT ?", "Cards are read in ID order so the ID index is used")
+			assert.Equal(strings.Count(query, "?"), len(args), "Each placeholder needs an arg")
+			assert.Equal(tt.expectedArgs, args, "Start ID and count are bound after filters")
+		})
+	}
+}
+
+func TestRandomCardStartID(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("00000000", randomCardStartID(0))
+	assert.Equal("89631139", randomCardStartID(89631139))
+	assert.Equal("89631139", randomCardStartID(5*cardIDKeyspace+89631139), "Seed wraps around the ID keyspace")
+	assert.Equal(randomCardStartID(123456789), randomCardStartID(123456789), "Same seed should start at the same ID")
+}
+
+func TestBuildCardTextCondition(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName          string
+		query             string
+		expectedCondition string
+		expectedArgs      []any
+	}{
+		{
+			testName:          "Single term",
+			query:             "banish",
+			expectedCondition: effectTextCondition,
+			expectedArgs:      []any{"banish"},
+		},
+		{
+			testName:          "Boolean operators",
+			query:             `banish AND "face-down" NOT "Graveyard"`,
+			expectedCondition: "(" + effectTextCondition + " AND " + effectTextCondition + " AND NOT " + effectTextCondition + ")",
+			expectedArgs:      []any{"banish", `"face down"`, `"Graveyard"`},
+		},
+		{
+			testName:          "Name field w/ LIKE wildcards",
+			query:             `name:"100%" OR name:T_G*`,
+			expectedCondition: "(" + nameTextCondition + " OR " + nameTextCondition + ")",
+			expectedArgs:      []any{`%100\%%`, `%T\_G%`},
+		},
+		{
+			testName:          "SQL and boolean mode characters are not part of args",
+			query:             `1=1'; DROP TABLE card_info; -- +draw`,
+			expectedCondition: "(" + strings.Repeat(effectTextCondition+" AND ", 4) + effectTextCondition + ")",
+			expectedArgs:      []any{`"1 1"`, "DROP", "TABLE", "card_info", "draw"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			q, err := parser.ParseQuery(tt.query)
+			if !assert.Nil(err) {
+				return
+			}
+
+			condition, args := buildCardTextCondition(q)
+			assert.Equal(tt.expectedCondition, condition)
+			assert.Equal(tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestMonsterTypeFilterConditions(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName           string
+		filter             *ygo.MonsterTypeFilter
+		expectedConditions []string
+		expectedArgs       []any
+	}{
+		{testName: "No filter", filter: nil, expectedConditions: nil, expectedArgs: nil},
+		{
+			testName:           "Type",
+			filter:             &ygo.MonsterTypeFilter{Type: "Beast_Warrior"},
+			expectedConditions: []string{`CONCAT(monster_type, '/') LIKE ?`},
+			expectedArgs:       []any{`Beast\_Warrior/%`},
+		},
+		{
+			testName:           "Flags",
+			filter:             &ygo.MonsterTypeFilter{Tuner: wrapperspb.Bool(true), Pendulum: wrapperspb.Bool(false)},
+			expectedConditions: []string{`CONCAT('/', monster_type, '/') LIKE ?`, `CONCAT('/', monster_type, '/') NOT LIKE ?`},
+			expectedArgs:       []any{"%/Tuner/%", "%/Pendulum/%"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			conditions, args := monsterTypeFilterConditions(tt.filter)
+			assert.Equal(tt.expectedConditions, conditions)
+			assert.Equal(tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestStatRangeCondition(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName          string
+		statRange         *ygo.StatRange
+		expectedCondition string
+		expectedArgs      []any
+	}{
+		{testName: "No range", statRange: nil, expectedCondition: "", expectedArgs: nil},
+		{testName: "Empty range", statRange: &ygo.StatRange{}, expectedCondition: "", expectedArgs: nil},
+		{
+			testName:          "Min only",
+			statRange:         &ygo.StatRange{Min: wrapperspb.UInt32(2000)},
+			expectedCondition: "(monster_attack >= ?)",
+			expectedArgs:      []any{uint32(2000)},
+		},
+		{
+			testName:          "Min and max",
+			statRange:         &ygo.StatRange{Min: wrapperspb.UInt32(0), Max: wrapperspb.UInt32(1500)},
+			expectedCondition: "(monster_attack >= ? AND monster_attack <= ?)",
+			expectedArgs:      []any{uint32(0), uint32(1500)},
+		},
+		{
+			testName:          "Unknown only",
+			statRange:         &ygo.StatRange{IncludeUnknown: true},
+			expectedCondition: "(monster_attack IS NULL AND monster_type IS NOT NULL)",
+			expectedArgs:      []any{},
+		},
+		{
+			testName:          "Max and unknown",
+			statRange:         &ygo.StatRange{Max: wrapperspb.UInt32(1000), IncludeUnknown: true},
+			expectedCondition: "((monster_attack <= ?) OR (monster_attack IS NULL AND monster_type IS NOT NULL))",
+			expectedArgs:      []any{uint32(1000)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			condition, args := statRangeCondition("monster_attack", tt.statRange)
+			assert.Equal(tt.expectedCondition, condition)
+			assert.Equal(tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestBuildCardSearchConditions(t *testing.T) {
+	assert := assert.New(t)
+	negatePattern, _ := parser.EffectTagPattern(parser.NegateTag)
+
+	tests := []struct {
+		testName           string
+		filter             *ygo.CardSearchFilter
+		expectedConditions []string
+		expectedArgs       []any
+	}{
+		{testName: "No filter", filter: nil, expectedConditions: []string{}, expectedArgs: []any{}},
+		{
+			testName:           "Colors and attributes",
+			filter:             &ygo.CardSearchFilter{Colors: []string{"Fusion", "Synchro"}, Attributes: []string{"DARK"}},
+			expectedConditions: []string{"card_color IN (?, ?)", "card_attribute IN (?)"},
+			expectedArgs:       []any{"Fusion", "Synchro", "DARK"},
+		},
+		{
+			testName:           "Monster types match whole tokens",
+			filter:             &ygo.CardSearchFilter{MonsterTypes: []string{"Beast_Warrior", "Tuner"}},
+			expectedConditions: []string{`CONCAT('/', monster_type, '/') LIKE ?`, `CONCAT('/', monster_type, '/') LIKE ?`},
+			expectedArgs:       []any{`%/Beast\_Warrior/%`, "%/Tuner/%"},
+		},
+		{
+			testName: "Stats, name and tags",
+			filter: &ygo.CardSearchFilter{
+				Attack: &ygo.StatRange{Min: wrapperspb.UInt32(3000)}, Defense: &ygo.StatRange{IncludeUnknown: true},
+				Name: "100%", Tags: []ygo.EffectTag{ygo.EffectTag_NEGATE},
+			},
+			expectedConditions: []string{
+				"(monster_attack >= ?)", "(monster_defense IS NULL AND monster_type IS NOT NULL)", "card_name LIKE ?", "card_effect REGEXP ?",
+			},
+			expectedArgs: []any{uint32(3000), `%100\%%`, "(?i)" + negatePattern},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			conditions, args := buildCardSearchConditions(tt.filter)
+			assert.Equal(tt.expectedConditions, conditions)
+			assert.Equal(tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestCollectTaggedCards(t *testing.T) {
+	assert := assert.New(t)
+
+	// every third card draws, every card is returned by the DB as the pattern used in SQL is looser than the tag
+	cards := make([]*ygo.Card, 10)
+	for i := range cards {
+		cards[i] = &ygo.Card{ID: fmt.Sprintf("%08d", i)}
+		if i%3 == 0 {
+			cards[i].Tags = []*ygo.EffectTagMatch{{Tag: ygo.EffectTag_DRAW}}
+		}
+	}
+
+	tests := []struct {
+		testName        string
+		lastCardID      string
+		pageSize        int
+		tags            []ygo.EffectTag
+		expectedIDs     []string
+		expectedNextID  string
+		expectedFetches int
+	}{
+		{
+			testName: "No tags needs one batch", pageSize: 3,
+			expectedIDs: []string{"00000000", "00000001", "00000002"}, expectedNextID: "00000002", expectedFetches: 1,
+		},
+		{
+			testName: "Tags fetch more batches until page is full", pageSize: 2, tags: []ygo.EffectTag{ygo.EffectTag_DRAW},
+			expectedIDs: []string{"00000000", "00000003"}, expectedNextID: "00000003", expectedFetches: 3,
+		},
+		{
+			testName: "Tags stop when cards run out", pageSize: 5, tags: []ygo.EffectTag{ygo.EffectTag_DRAW},
+			expectedIDs: []string{"00000000", "00000003", "00000006", "00000009"}, expectedFetches: 2,
+		},
+		{
+			testName: "Cursor", lastCardID: "00000003", pageSize: 1, tags: []ygo.EffectTag{ygo.EffectTag_DRAW},
+			expectedIDs: []string{"00000006"}, expectedNextID: "00000006", expectedFetches: 3,
+		},
+		{
+			testName: "Every tag is needed", pageSize: 3, tags: []ygo.EffectTag{ygo.EffectTag_DRAW, ygo.EffectTag_NEGATE},
+			expectedIDs: []string{}, expectedFetches: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			fetches := 0
+			collected, nextID, err := collectTaggedCards(tt.lastCardID, tt.pageSize, tt.tags, func(lastCardID string) ([]*ygo.Card, *status.Status) {
+				fetches++
+				start := 0
+				if lastCardID != "" {
+					start = slices.IndexFunc(cards, func(c *ygo.Card) bool { return c.ID == lastCardID }) + 1
+				}
+				return cards[start:min(start+tt.pageSize+1, len(cards))], nil
+			})
+
+			assert.Nil(err)
+			ids := make([]string, len(collected))
+			for i, c := range collected {
+				ids[i] = c.ID
+			}
+			assert.Equal(tt.expectedIDs, ids)
+			assert.Equal(tt.expectedNextID, nextID)
+			assert.Equal(tt.expectedFetches, fetches)
+		})
+	}
+
+	// no card has the tag, each batch holds 2 cards
+	fetches, manyCards := 0, make([]*ygo.Card, 100)
+	for i := range manyCards {
+		manyCards[i] = &ygo.Card{ID: fmt.Sprintf("%08d", i)}
+	}
+	collected, nextID, err := collectTaggedCards("", 1, []ygo.EffectTag{ygo.EffectTag_NEGATE}, func(string) ([]*ygo.Card, *status.Status) {
+		fetches++
+		return manyCards[2*(fetches-1) : 2*fetches], nil
+	})
+	assert.Nil(err)
+	assert.Empty(collected)
+	assert.Equal(maxTaggedSearchBatches, fetches, "Batches stop at the limit even though there are more cards")
+	assert.Equal(fmt.Sprintf("%08d", 2*maxTaggedSearchBatches-1), nextID, "Next page starts after the last card checked")
+
+	_, _, err = collectTaggedCards("", 3, nil, func(string) ([]*ygo.Card, *status.Status) {
+		return nil, status.New(codes.Internal, genericError)
+	})
+	assert.Equal(codes.Internal, err.Code())
+}
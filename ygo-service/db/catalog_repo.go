@@ -0,0 +1,308 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/util"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	cardColorCatalogQuery = `
+SELECT
+	cc.color_id,
+	cc.card_color,
+	COUNT(ci.card_number)
+FROM
+	card_colors cc
+	LEFT JOIN card_info ci ON ci.color_id = cc.color_id
+GROUP BY
+	cc.color_id,
+	cc.card_color
+ORDER BY
+	cc.color_id`
+
+	cardAttributeCountsQuery = `
+SELECT
+	card_attribute,
+	COUNT(*)
+FROM
+	card_info
+GROUP BY
+	card_attribute`
+
+	// monster types are split into tokens by the service, grouping by the whole string keeps the result small
+	monsterTypeCountsQuery = `
+SELECT
+	monster_type,
+	COUNT(*)
+FROM
+	card_info
+WHERE
+	monster_type IS NOT NULL
+GROUP BY
+	monster_type`
+
+	productTypeCountsQuery = `
+SELECT
+	product_type,
+	product_sub_type,
+	COUNT(*)
+FROM
+	products
+GROUP BY
+	product_type,
+	product_sub_type`
+
+	rarityCountsQuery = `
+SELECT
+	card_rarity,
+	COUNT(*)
+FROM
+	product_contents
+GROUP BY
+	card_rarity`
+
+	localeCountsQuery = `
+SELECT
+	product_locale,
+	COUNT(*)
+FROM
+	products
+GROUP BY
+	product_locale`
+)
+
+// monster attributes are displayed before Spell and Trap, which are stored as attributes too
+var attributeDisplayOrder = []string{"DARK", "DIVINE", "EARTH", "FIRE", "LIGHT", "WATER", "WIND", "SPELL", "TRAP"}
+
+type CatalogRepository interface {
+	GetCardColorCatalog(context.Context) (*ygo.CardColorCatalog, *status.Status)
+	GetCardAttributes(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetMonsterTypes(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetMonsterAbilities(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetProductTypes(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetProductSubTypes(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetRarities(context.Context) (*ygo.CatalogEntries, *status.Status)
+	GetLocales(context.Context) (*ygo.CatalogEntries, *status.Status)
+}
+type YGOCatalogRepository struct{}
+
+func (imp YGOCatalogRepository) GetCardColorCatalog(ctx context.Context) (*ygo.CardColorCatalog, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving card color catalog")
+
+	if rows, err := skcDBConn.Query(cardColorCatalogQuery); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		colors := make([]*ygo.CardColorEntry, 0, 18)
+		var (
+			colorID, count uint32
+			color          string
+		)
+
+		for rows.Next() {
+			if err := rows.Scan(&colorID, &color, &count); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+			// IDs known by the model package might not match the table, colors are classified by name instead
+			entry := &ygo.CardColorEntry{ID: colorID, Name: color, Count: count, DisplayOrder: uint32(len(colors))}
+			if classification := model.ClassifyColor(color); classification != nil {
+				entry.DeckLocation, entry.Category = classification.DeckLocation, classification.Category
+			}
+			colors = append(colors, entry)
+		}
+		return &ygo.CardColorCatalog{Colors: colors}, nil
+	}
+}
+
+func (imp YGOCatalogRepository) GetCardAttributes(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving card attributes")
+
+	counts, err := queryCatalogCounts(logger, cardAttributeCountsQuery)
+	if err != nil {
+		return nil, err
+	}
+	return buildCatalogEntries(counts, "", func(a, b string) int {
+		return compareByDisplayOrder(attributeDisplayOrder, a, b)
+	}), nil
+}
+
+// first token of a monster type is the type (Spellcaster, Dragon, etc) and the rest are abilities (Tuner, Effect, etc)
+func (imp YGOCatalogRepository) GetMonsterTypes(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving monster types")
+
+	return queryMonsterTypeTokens(logger, func(tokens []string) []string { return tokens[:1] })
+}
+
+func (imp YGOCatalogRepository) GetMonsterAbilities(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving monster abilities")
+
+	return queryMonsterTypeTokens(logger, func(tokens []string) []string { return tokens[1:] })
+}
+
+func queryMonsterTypeTokens(logger *slog.Logger, tokensToCount func([]string) []string) (*ygo.CatalogEntries, *status.Status) {
+	monsterTypes, err := queryCatalogCounts(logger, monsterTypeCountsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]uint32)
+	for monsterType, count := range monsterTypes {
+		for _, token := range tokensToCount(strings.Split(monsterType, "/")) {
+			counts[strings.TrimSpace(token)] += count
+		}
+	}
+	return buildCatalogEntries(counts, "", strings.Compare), nil
+}
+
+func (imp YGOCatalogRepository) GetProductTypes(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving product types")
+
+	subTypeCounts, err := queryProductTypeCounts(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]uint32, len(subTypeCounts))
+	for productType, subTypes := range subTypeCounts {
+		for _, count := range subTypes {
+			counts[productType] += count
+		}
+	}
+	return buildCatalogEntries(counts, "", strings.Compare), nil
+}
+
+// sub types are ordered by product type first so sub types of the same product type are displayed together
+func (imp YGOCatalogRepository) GetProductSubTypes(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving product sub types")
+
+	subTypeCounts, err := queryProductTypeCounts(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	productTypes := make([]string, 0, len(subTypeCounts))
+	for productType := range subTypeCounts {
+		productTypes = append(productTypes, productType)
+	}
+	slices.Sort(productTypes)
+
+	entries := make([]*ygo.CatalogEntry, 0)
+	for _, productType := range productTypes {
+		for _, entry := range buildCatalogEntries(subTypeCounts[productType], productType, strings.Compare).Entries {
+			entry.DisplayOrder = uint32(len(entries))
+			entries = append(entries, entry)
+		}
+	}
+	return &ygo.CatalogEntries{Entries: entries}, nil
+}
+
+func queryProductTypeCounts(logger *slog.Logger) (map[string]map[string]uint32, *status.Status) {
+	if rows, err := skcDBConn.Query(productTypeCountsQuery); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		counts := make(map[string]map[string]uint32)
+		var (
+			productType, subType string
+			count                uint32
+		)
+
+		for rows.Next() {
+			if err := rows.Scan(&productType, &subType, &count); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+			if _, exists := counts[productType]; !exists {
+				counts[productType] = make(map[string]uint32)
+			}
+			counts[productType][subType] += count
+		}
+		return counts, nil
+	}
+}
+
+// count is the number of printings using the rarity
+func (imp YGOCatalogRepository) GetRarities(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving rarities")
+
+	counts, err := queryCatalogCounts(logger, rarityCountsQuery)
+	if err != nil {
+		return nil, err
+	}
+	return buildCatalogEntries(counts, "", strings.Compare), nil
+}
+
+func (imp YGOCatalogRepository) GetLocales(ctx context.Context) (*ygo.CatalogEntries, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info("Retrieving product locales")
+
+	counts, err := queryCatalogCounts(logger, localeCountsQuery)
+	if err != nil {
+		return nil, err
+	}
+	return buildCatalogEntries(counts, "", strings.Compare), nil
+}
+
+// query needs to select a name and its count
+func queryCatalogCounts(logger *slog.Logger, query string) (map[string]uint32, *status.Status) {
+	if rows, err := skcDBConn.Query(query); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		counts := make(map[string]uint32)
+		var (
+			name  sql.NullString
+			count uint32
+		)
+
+		for rows.Next() {
+			if err := rows.Scan(&name, &count); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+			if name.Valid && name.String != "" {
+				counts[name.String] += count
+			}
+		}
+		return counts, nil
+	}
+}
+
+func buildCatalogEntries(counts map[string]uint32, parent string, compare func(string, string) int) *ygo.CatalogEntries {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, compare)
+
+	entries := make([]*ygo.CatalogEntry, len(names))
+	for i, name := range names {
+		entries[i] = &ygo.CatalogEntry{Name: name, Count: counts[name], DisplayOrder: uint32(i), Parent: parent}
+	}
+	return &ygo.CatalogEntries{Entries: entries}
+}
+
+// values not part of order are displayed last using alphabetical order
+func compareByDisplayOrder(order []string, a, b string) int {
+	aIndex, bIndex := slices.Index(order, strings.ToUpper(a)), slices.Index(order, strings.ToUpper(b))
+	switch {
+	case aIndex == bIndex:
+		return strings.Compare(a, b)
+	case aIndex == -1:
+		return 1
+	case bIndex == -1:
+		return -1
+	default:
+		return aIndex - bIndex
+	}
+}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+func TestCompareByDisplayOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		a, b     string
+		expected int // only the sign matters
+	}{
+		{testName: "Both in order", a: "DARK", b: "LIGHT", expected: -1},
+		{testName: "Both in order reversed", a: "TRAP", b: "SPELL", expected: 1},
+		{testName: "Order ignores case", a: "Spell", b: "dark", expected: 1},
+		{testName: "Same value", a: "FIRE", b: "FIRE", expected: 0},
+		{testName: "Unknown value is last", a: "LAUGH", b: "TRAP", expected: 1},
+		{testName: "Known value is first", a: "WIND", b: "LAUGH", expected: -1},
+		{testName: "Unknown values are alphabetical", a: "ZEPHYR", b: "LAUGH", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			result := compareByDisplayOrder(attributeDisplayOrder, tt.a, tt.b)
+			switch {
+			case tt.expected < 0:
+				assert.Negative(result)
+			case tt.expected > 0:
+				assert.Positive(result)
+			default:
+				assert.Zero(result)
+			}
+		})
+	}
+}
+
+func TestBuildCatalogEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName string
+		counts   map[string]uint32
+		parent   string
+		compare  func(string, string) int
+		expected []*ygo.CatalogEntry
+	}{
+		{testName: "No entries", counts: map[string]uint32{}, compare: strings.Compare, expected: []*ygo.CatalogEntry{}},
+		{
+			testName: "Alphabetical",
+			counts:   map[string]uint32{"Ultra Rare": 7, "Common": 120, "Secret Rare": 2},
+			compare:  strings.Compare,
+			expected: []*ygo.CatalogEntry{
+				{Name: "Common", Count: 120, DisplayOrder: 0},
+				{Name: "Secret Rare", Count: 2, DisplayOrder: 1},
+				{Name: "Ultra Rare", Count: 7, DisplayOrder: 2},
+			},
+		},
+		{
+			testName: "Display order with parent",
+			counts:   map[string]uint32{"SPELL": 30, "LAUGH": 1, "DARK": 10, "TRAP": 20},
+			parent:   "Attributes",
+			compare:  func(a, b string) int { return compareByDisplayOrder(attributeDisplayOrder, a, b) },
+			expected: []*ygo.CatalogEntry{
+				{Name: "DARK", Count: 10, DisplayOrder: 0, Parent: "Attributes"},
+				{Name: "SPELL", Count: 30, DisplayOrder: 1, Parent: "Attributes"},
+				{Name: "TRAP", Count: 20, DisplayOrder: 2, Parent: "Attributes"},
+				{Name: "LAUGH", Count: 1, DisplayOrder: 3, Parent: "Attributes"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(tt.expected, buildCatalogEntries(tt.counts, tt.parent, tt.compare).Entries)
+		})
+	}
+}
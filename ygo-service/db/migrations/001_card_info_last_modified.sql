@@ -0,0 +1,5 @@
+-- StreamAllCards filters on last_modified so clients can only sync cards that changed since their last export.
+-- Existing rows are stamped with the time of the migration, meaning the first incremental sync after it runs returns every card.
+ALTER TABLE card_info
+	ADD COLUMN last_modified TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	ADD INDEX card_info_last_modified_idx (last_modified);
@@ -1,11 +1,16 @@
 package db
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ygo-skc/skc-go/common/v2/model"
+	"github.com/ygo-skc/skc-go/common/v2/parser"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 	"google.golang.org/grpc/codes"
@@ -26,18 +31,105 @@ FROM
 WHERE
 	product_id = ?`
 
+	// joins the earliest product containing each card, products without a release date are considered last.
+	// Products missing from product_info are kept so none of the items of the requested product are dropped.
 	cardsByProductIDQuery = `
 SELECT
 	%s,
 	product_position,
-	card_rarity
+	card_rarity,
+	first_printing_product_id,
+	first_printing_date
 FROM
 	product_contents
+	LEFT JOIN (
+		SELECT
+			pc.card_number AS first_printing_card_number,
+			pc.product_id AS first_printing_product_id,
+			pi.product_release_date AS first_printing_date,
+			ROW_NUMBER() OVER (PARTITION BY pc.card_number ORDER BY pi.product_release_date IS NULL, pi.product_release_date, pc.product_id) AS printing
+		FROM
+			product_contents pc
+			LEFT JOIN product_info pi ON pc.product_id = pi.product_id
+		WHERE
+			pc.card_number IN (SELECT card_number FROM product_contents WHERE product_id = ?)
+	) first_printings ON card_number = first_printing_card_number AND printing = 1
 WHERE
 	product_id = ?
 ORDER BY
 	product_position`
 
+	// product_name uses a case-insensitive collation, comparing the column directly keeps its index usable
+	productInfoByNames = `
+SELECT
+	product_id,
+	product_locale,
+	product_name,
+	product_type,
+	product_sub_type,
+	product_release_date,
+	product_content_total
+FROM
+	product_info
+WHERE
+	product_name IN (%s)
+ORDER BY
+	product_release_date,
+	product_id`
+
+	searchProductsQuery = `
+SELECT
+	product_id,
+	product_locale,
+	product_name,
+	product_type,
+	product_sub_type,
+	product_release_date,
+	product_content_total
+FROM
+	product_info
+WHERE
+	%s
+ORDER BY
+	%s
+LIMIT
+	?`
+	searchProductsCursorCondition = `(product_release_date, product_id) %s (SELECT product_release_date, product_id FROM product_info WHERE product_id = ?)`
+	productExistsQuery            = `SELECT EXISTS(SELECT 1 FROM product_info WHERE product_id = ?)`
+
+	cardsByPrintCodesQuery = `
+SELECT
+	%s,
+	product_id,
+	product_position,
+	card_rarity
+FROM
+	product_contents
+WHERE
+	(product_id, product_position) IN (%s)`
+
+	cardPrintingsQuery = `
+SELECT
+	%s,
+	pc.product_id,
+	pi.product_locale,
+	pi.product_name,
+	pi.product_type,
+	pi.product_sub_type,
+	pi.product_release_date,
+	pi.product_content_total,
+	pc.product_position,
+	pc.card_rarity
+FROM
+	product_contents pc
+	JOIN product_info pi ON pc.product_id = pi.product_id
+WHERE
+	pc.card_number IN (%s)
+ORDER BY
+	pi.product_release_date,
+	pc.product_id,
+	pc.product_position`
+
 	productInfoByIDs = `
 SELECT
 	product_id,
@@ -53,18 +145,77 @@ WHERE
 	product_id IN (%s)`
 )
 
-func parseRowsForProductItems(ctx context.Context, rows *sql.Rows) ([]*ygo.ProductItem, map[string]uint32, *status.Status) {
+const (
+	defaultProductSearchPageSize = 50
+	maxProductSearchPageSize     = 200
+	releaseDateLayout            = "2006-01-02"
+)
+
+func buildProductSearchConditions(filter *ygo.ProductSearchFilter) ([]string, []any, *status.Status) {
+	conditions := make([]string, 0, 6)
+	args := make([]any, 0, 10)
+
+	for _, f := range []struct {
+		column string
+		values []string
+	}{{"product_type", filter.GetTypes()}, {"product_sub_type", filter.GetSubTypes()}, {"product_locale", filter.GetLocales()}} {
+		if len(f.values) > 0 {
+			conditions = append(conditions, fmt.Sprintf("%s IN (%s)", f.column, variablePlaceholders(len(f.values))))
+			valueArgs, _ := buildVariableQuerySubjects(f.values)
+			args = append(args, valueArgs...)
+		}
+	}
+	for _, bound := range []struct{ value, comparison string }{{filter.GetReleasedAfter(), ">="}, {filter.GetReleasedBefore(), "<="}} {
+		if bound.value == "" {
+			continue
+		}
+		if _, err := time.Parse(releaseDateLayout, bound.value); err != nil {
+			return nil, nil, status.New(codes.InvalidArgument, fmt.Sprintf("Release date %s should use format YYYY-MM-DD", bound.value))
+		}
+		conditions = append(conditions, fmt.Sprintf("product_release_date %s ?", bound.comparison))
+		args = append(args, bound.value)
+	}
+	if name := strings.TrimSpace(filter.GetName()); name != "" {
+		conditions = append(conditions, "product_name LIKE ?")
+		args = append(args, "%"+escapeLike(name)+"%")
+	}
+
+	return conditions, args, nil
+}
+
+// sort keys and the comparison used by the cursor condition to find products after the cursor
+func productSortKeys(sortOrder ygo.ProductSortOrder) (string, string) {
+	if sortOrder == ygo.ProductSortOrder_RELEASE_DATE_ASC {
+		return "product_release_date, product_id", ">"
+	}
+	return "product_release_date DESC, product_id DESC", "<"
+}
+
+func productSearchPageSize(requested uint32) int {
+	switch {
+	case requested == 0:
+		return defaultProductSearchPageSize
+	case requested > maxProductSearchPageSize:
+		return maxProductSearchPageSize
+	default:
+		return int(requested)
+	}
+}
+
+func parseRowsForProductItems(ctx context.Context, rows *sql.Rows, product *ygo.Product) ([]*ygo.ProductItem, map[string]uint32, *status.Status) {
 	items := make([]*ygo.ProductItem, 0)
 	itemByCardIDxPosition := make(map[string]*ygo.ProductItem)
 	rarityDistribution := make(map[string]uint32)
 	var (
-		id, color, name, attribute, effect string
-		monsterType                        *string
-		atk, def                           *uint32
-		productPosition, rarity            string
+		id, color, name, attribute, effect        string
+		monsterType                               *string
+		atk, def                                  *uint32
+		productPosition, rarity                   string
+		firstPrintingProductID, firstPrintingDate sql.NullString
 	)
 	for rows.Next() {
-		if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def, &productPosition, &rarity); err != nil {
+		if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def, &productPosition, &rarity,
+			&firstPrintingProductID, &firstPrintingDate); err != nil {
 			return nil, nil, handleRowParsingError(util.RetrieveLogger(ctx), err)
 		} else {
 			// either create a new ProductItem or use reference to existing Item and update the rarities
@@ -73,11 +224,11 @@ func parseRowsForProductItems(ctx context.Context, rows *sql.Rows) ([]*ygo.Produ
 				itemByCardIDxPosition[key].Rarities = append(itemByCardIDxPosition[key].Rarities, rarity)
 			} else {
 				item := &ygo.ProductItem{
-					Card: model.NewYGOCardProtoBuilder(id, name).WithColor(color).
-						WithAttribute(attribute).WithEffect(effect).WithMonsterType(monsterType).WithAttack(atk).WithDefense(def).Build(),
+					Card:     buildCard(id, color, name, attribute, effect, monsterType, atk, def),
 					Position: productPosition,
 					Rarities: []string{rarity},
 				}
+				setFirstPrinting(item, product, firstPrintingProductID, firstPrintingDate)
 				items = append(items, item)
 				itemByCardIDxPosition[key] = item
 			}
@@ -94,11 +245,40 @@ func parseRowsForProductItems(ctx context.Context, rows *sql.Rows) ([]*ygo.Produ
 	return items, rarityDistribution, nil
 }
 
+// Cards released the same day in another product are not reprints. Items without first printing data (ie: none of the products containing the card have a release date)
+// are treated as first printings.
+func setFirstPrinting(item *ygo.ProductItem, product *ygo.Product, firstPrintingProductID, firstPrintingDate sql.NullString) {
+	item.FirstPrinting = !firstPrintingProductID.Valid || !firstPrintingDate.Valid || firstPrintingProductID.String == product.ID ||
+		firstPrintingDate.String >= product.ReleaseDate
+	if !item.FirstPrinting {
+		item.FirstPrintingProductID = firstPrintingProductID.String
+		item.FirstPrintingDate = firstPrintingDate.String
+	}
+}
+
+func countPrintings(items []*ygo.ProductItem) (newItems, reprintedItems uint32) {
+	for _, item := range items {
+		if item.FirstPrinting {
+			newItems++
+		} else {
+			reprintedItems++
+		}
+	}
+	return newItems, reprintedItems
+}
+
 type ProductRepository interface {
 	GetCardsByProductID(context.Context, string) (*ygo.Product, *status.Status)
 
 	GetProductSummaryByID(context.Context, string) (*ygo.ProductSummary, *status.Status)
 	GetProductsSummaryByID(context.Context, model.ProductIDs) (*ygo.Products, *status.Status)
+	GetProductsSummaryByName(context.Context, model.ProductNames) (*ygo.Products, *status.Status)
+	SearchProducts(context.Context, *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, *status.Status)
+
+	GetCardsByPrintCode(context.Context, model.PrintCodes) (*ygo.PrintedCards, *status.Status)
+
+	GetCardPrintings(context.Context, string) (*ygo.CardPrintings, *status.Status)
+	GetCardPrintingsByIDs(context.Context, model.CardIDs) (*ygo.BatchCardPrintings, *status.Status)
 }
 type YGOProductRepository struct{}
 
@@ -110,15 +290,16 @@ func (imp YGOProductRepository) GetCardsByProductID(ctx context.Context, product
 		return nil, err
 	} else {
 		query := fmt.Sprintf(cardsByProductIDQuery, cardAttributes)
-		if rows, err := skcDBConn.Query(query, productID); err != nil {
+		if rows, err := skcDBConn.Query(query, productID, productID); err != nil {
 			return nil, handleQueryError(logger, err)
 		} else {
-			if items, rarityDistribution, err := parseRowsForProductItems(ctx, rows); err != nil {
+			if items, rarityDistribution, err := parseRowsForProductItems(ctx, rows, product); err != nil {
 				return nil, err
 			} else {
 				product.Items = items
 				product.TotalItems = uint32(len(items))
 				product.RarityDistribution = rarityDistribution
+				product.NewItems, product.ReprintedItems = countPrintings(items)
 				return product, nil
 			}
 		}
@@ -166,3 +347,301 @@ func (imp YGOProductRepository) GetProductsSummaryByID(ctx context.Context, prod
 		UnknownResources: model.FindMissingKeys(productData, products),
 	}, nil
 }
+
+// Names are matched case-insensitively and results are keyed by the name as requested. If several products share a name, the earliest release is used.
+func (imp YGOProductRepository) GetProductsSummaryByName(ctx context.Context, productNames model.ProductNames) (*ygo.Products, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving summary of products using %d different name(s)", len(productNames)))
+
+	productData := make(map[string]*ygo.ProductSummary, len(productNames))
+	if len(productNames) == 0 {
+		return &ygo.Products{Products: productData, UnknownResources: productNames}, nil
+	}
+
+	requestedNames, args := groupProductNames(productNames)
+	query := fmt.Sprintf(productInfoByNames, variablePlaceholders(len(args)))
+	if rows, err := skcDBConn.Query(query, args...); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		for rows.Next() {
+			var id, locale, name, t, subType, releaseDate string
+			var totalItems uint32
+
+			if err := rows.Scan(&id, &locale, &name, &t, &subType, &releaseDate, &totalItems); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+
+			product := &ygo.ProductSummary{ID: id, Locale: locale, Name: name, Type: t, SubType: subType, ReleaseDate: releaseDate, TotalItems: totalItems}
+			for _, requestedName := range requestedNames[strings.ToLower(name)] {
+				if _, exists := productData[requestedName]; !exists {
+					productData[requestedName] = product
+				}
+			}
+		}
+	}
+
+	return &ygo.Products{
+		Products:         productData,
+		UnknownResources: model.FindMissingKeys(productData, productNames),
+	}, nil
+}
+
+// Names are matched ignoring case, each lowercase name is queried once and maps to every name as requested.
+func groupProductNames(productNames model.ProductNames) (map[string][]string, []any) {
+	requestedNames := make(map[string][]string, len(productNames))
+	args := make([]any, 0, len(productNames))
+	for _, name := range productNames {
+		key := strings.ToLower(name)
+		if _, exists := requestedNames[key]; !exists {
+			args = append(args, key)
+		}
+		if !slices.Contains(requestedNames[key], name) {
+			requestedNames[key] = append(requestedNames[key], name)
+		}
+	}
+	return requestedNames, args
+}
+
+// Finds products matching all criteria in the filter. Results are paginated using the ID of the last product of the previous page as the cursor.
+func (imp YGOProductRepository) SearchProducts(ctx context.Context, req *ygo.ProductSearchRequest) (*ygo.ProductSearchResults, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Searching products using filter %v and sort order %s", req.Filter, req.SortOrder))
+
+	conditions, args, err := buildProductSearchConditions(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	sortKeys, cursorComparison := productSortKeys(req.SortOrder)
+
+	if req.Cursor != "" {
+		if lastProductID, err := validateCursor(logger, productExistsQuery, req.Cursor); err != nil {
+			return nil, err
+		} else {
+			conditions = append(conditions, fmt.Sprintf(searchProductsCursorCondition, cursorComparison))
+			args = append(args, lastProductID)
+		}
+	}
+
+	pageSize := productSearchPageSize(req.PageSize)
+	args = append(args, pageSize+1) // fetch one extra row to determine if there is another page
+
+	query := fmt.Sprintf(searchProductsQuery, joinConditions(conditions), sortKeys)
+	if rows, err := skcDBConn.Query(query, args...); err != nil {
+		return nil, handleQueryError(logger, err)
+	} else {
+		products := make([]*ygo.ProductSummary, 0, pageSize+1)
+		for rows.Next() {
+			var id, locale, name, t, subType, releaseDate string
+			var totalItems uint32
+
+			if err := rows.Scan(&id, &locale, &name, &t, &subType, &releaseDate, &totalItems); err != nil {
+				return nil, handleRowParsingError(logger, err)
+			}
+
+			products = append(products, &ygo.ProductSummary{ID: id, Locale: locale, Name: name, Type: t, SubType: subType, ReleaseDate: releaseDate, TotalItems: totalItems})
+		}
+
+		results := &ygo.ProductSearchResults{Products: products}
+		if len(products) > pageSize {
+			results.Products = products[:pageSize]
+			results.NextCursor = encodeCursor(products[pageSize-1].ID)
+		}
+
+		logger.Info(fmt.Sprintf("Search returned %d product(s)", len(results.Products)))
+		return results, nil
+	}
+}
+
+// Invalid print codes and codes that do not resolve to a card are returned as unknown resources.
+// The locale of a print code needs to match the locale of the product, codes without a locale (ie: LOB-001) match any locale.
+func (imp YGOProductRepository) GetCardsByPrintCode(ctx context.Context, printCodes model.PrintCodes) (*ygo.PrintedCards, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving cards using the following print codes: %v", printCodes))
+
+	printedCards := make(map[string]*ygo.PrintedCard, len(printCodes))
+	parsedCodes := make(map[string]*parser.PrintCode, len(printCodes))
+	args := make([]any, 0, 2*len(printCodes))
+	for _, code := range printCodes {
+		if printCode, err := parser.ParsePrintCode(code); err != nil {
+			logger.Warn(fmt.Sprintf("Print code %s is not valid", code))
+		} else if _, exists := parsedCodes[code]; !exists {
+			parsedCodes[code] = printCode
+			args = append(args, printCode.ProductID, printCode.Position)
+		}
+	}
+
+	if len(parsedCodes) != 0 {
+		items, err := queryItemsByPrintCode(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return &ygo.PrintedCards{PrintedCards: printedCards, UnknownResources: printCodes}, nil
+		}
+
+		productIDs := make(model.ProductIDs, 0, len(items))
+		for key := range items {
+			productIDs = append(productIDs, key.productID)
+		}
+		products, err := imp.GetProductsSummaryByID(ctx, productIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for code, printCode := range parsedCodes {
+			item, itemExists := items[printCodeKey{productID: printCode.ProductID, position: printCode.Position}]
+			product, productExists := products.Products[printCode.ProductID]
+			if itemExists && productExists && matchesPrintCodeLocale(printCode, product) {
+				printedCards[code] = &ygo.PrintedCard{Card: item.Card, Product: product, Position: item.Position, Rarities: item.Rarities}
+			}
+		}
+	}
+
+	return &ygo.PrintedCards{
+		PrintedCards:     printedCards,
+		UnknownResources: model.FindMissingKeys(printedCards, printCodes),
+	}, nil
+}
+
+type printCodeKey struct {
+	productID, position string
+}
+
+// args are product ID and position pairs. A card printed in multiple rarities has a row per rarity.
+func queryItemsByPrintCode(ctx context.Context, args []any) (map[printCodeKey]*ygo.ProductItem, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	query := fmt.Sprintf(cardsByPrintCodesQuery, cardAttributes, tuplePlaceholders(len(args)/2, 2))
+
+	rows, err := skcDBConn.Query(query, args...)
+	if err != nil {
+		return nil, handleQueryError(logger, err)
+	}
+
+	items := make(map[printCodeKey]*ygo.ProductItem, len(args)/2)
+	var (
+		id, color, name, attribute, effect string
+		monsterType                        *string
+		atk, def                           *uint32
+		productID, productPosition, rarity string
+	)
+	for rows.Next() {
+		if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def, &productID, &productPosition, &rarity); err != nil {
+			return nil, handleRowParsingError(logger, err)
+		}
+
+		key := printCodeKey{productID: productID, position: productPosition}
+		if item, exists := items[key]; exists {
+			item.Rarities = append(item.Rarities, rarity)
+		} else {
+			items[key] = &ygo.ProductItem{
+				Card:     buildCard(id, color, name, attribute, effect, monsterType, atk, def),
+				Position: productPosition,
+				Rarities: []string{rarity},
+			}
+		}
+	}
+	return items, nil
+}
+
+func matchesPrintCodeLocale(printCode *parser.PrintCode, product *ygo.ProductSummary) bool {
+	return printCode.Locale == "" || strings.EqualFold(printCode.Locale, product.Locale)
+}
+
+func (imp YGOProductRepository) GetCardPrintings(ctx context.Context, cardID string) (*ygo.CardPrintings, *status.Status) {
+	if results, err := imp.GetCardPrintingsByIDs(ctx, []string{cardID}); err != nil {
+		return nil, err
+	} else {
+		if printings, exists := results.CardInfo[cardID]; !exists {
+			return nil, status.New(codes.NotFound, "No results found")
+		} else {
+			return printings, nil
+		}
+	}
+}
+
+// Printings of each card are sorted by release date, oldest first. Cards that were never printed are returned as unknown resources.
+func (imp YGOProductRepository) GetCardPrintingsByIDs(ctx context.Context, cardIDs model.CardIDs) (*ygo.BatchCardPrintings, *status.Status) {
+	logger := util.RetrieveLogger(ctx)
+	logger.Info(fmt.Sprintf("Retrieving printings of the following cards: %v", cardIDs))
+
+	args, numCards := buildVariableQuerySubjects(cardIDs)
+	query := fmt.Sprintf(cardPrintingsQuery, cardAttributes, variablePlaceholders(numCards))
+
+	rows, err := skcDBConn.Query(query, args...)
+	if err != nil {
+		return nil, handleQueryError(logger, err)
+	}
+	defer rows.Close()
+
+	cards := make(map[string]*ygo.Card, numCards)
+	printingRows := make([]cardPrintingRow, 0)
+	var (
+		id, color, name, attribute, effect                      string
+		monsterType                                             *string
+		atk, def                                                *uint32
+		productID, locale, productName, t, subType, releaseDate string
+		totalItems                                              uint32
+		productPosition, rarity                                 string
+	)
+	for rows.Next() {
+		if err := rows.Scan(&id, &color, &name, &attribute, &effect, &monsterType, &atk, &def,
+			&productID, &locale, &productName, &t, &subType, &releaseDate, &totalItems, &productPosition, &rarity); err != nil {
+			return nil, handleRowParsingError(logger, err)
+		}
+
+		// card info is repeated in every row, it only needs to be built once
+		if _, exists := cards[id]; !exists {
+			cards[id] = buildCard(id, color, name, attribute, effect, monsterType, atk, def)
+		}
+		printingRows = append(printingRows, cardPrintingRow{
+			card:     cards[id],
+			product:  &ygo.ProductSummary{ID: productID, Locale: locale, Name: productName, Type: t, SubType: subType, ReleaseDate: releaseDate, TotalItems: totalItems},
+			position: productPosition,
+			rarity:   rarity,
+		})
+	}
+
+	return groupCardPrintings(printingRows, cardIDs), nil
+}
+
+type cardPrintingRow struct {
+	card             *ygo.Card
+	product          *ygo.ProductSummary
+	position, rarity string
+}
+
+// A card printed in multiple rarities has a row per rarity, those rows become a single printing. Printings of each card are sorted by release date
+// with product ID and position breaking ties so the order does not depend on the order of rows. Requested cards without rows are unknown resources.
+func groupCardPrintings(rows []cardPrintingRow, cardIDs model.CardIDs) *ygo.BatchCardPrintings {
+	type printingKey struct {
+		cardID, productID, position string
+	}
+
+	cardInfo := make(map[string]*ygo.CardPrintings, len(cardIDs))
+	printings := make(map[printingKey]*ygo.CardPrinting, len(rows))
+	for _, row := range rows {
+		key := printingKey{cardID: row.card.ID, productID: row.product.ID, position: row.position}
+		if printing, exists := printings[key]; exists {
+			printing.Rarities = append(printing.Rarities, row.rarity)
+			continue
+		}
+
+		if _, exists := cardInfo[row.card.ID]; !exists {
+			cardInfo[row.card.ID] = &ygo.CardPrintings{Card: row.card, Printings: make([]*ygo.CardPrinting, 0)}
+		}
+		printing := &ygo.CardPrinting{Product: row.product, Position: row.position, Rarities: []string{row.rarity}}
+		cardInfo[row.card.ID].Printings = append(cardInfo[row.card.ID].Printings, printing)
+		printings[key] = printing
+	}
+
+	for _, c := range cardInfo {
+		slices.SortFunc(c.Printings, func(a, b *ygo.CardPrinting) int {
+			return cmp.Or(cmp.Compare(a.Product.ReleaseDate, b.Product.ReleaseDate), cmp.Compare(a.Product.ID, b.Product.ID), cmp.Compare(a.Position, b.Position))
+		})
+	}
+
+	return &ygo.BatchCardPrintings{
+		CardInfo:         cardInfo,
+		UnknownResources: model.FindMissingKeys(cardInfo, cardIDs),
+	}
+}
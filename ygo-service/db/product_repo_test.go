@@ -0,0 +1,209 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBuildProductSearchConditions(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName           string
+		filter             *ygo.ProductSearchFilter
+		expectedConditions []string
+		expectedArgs       []any
+		expectedCode       codes.Code
+	}{
+		{testName: "No filter", filter: nil, expectedConditions: []string{}, expectedArgs: []any{}},
+		{
+			testName:           "Type and locale",
+			filter:             &ygo.ProductSearchFilter{Types: []string{"Pack", "Deck"}, Locales: []string{"EN"}},
+			expectedConditions: []string{"product_type IN (?, ?)", "product_locale IN (?)"},
+			expectedArgs:       []any{"Pack", "Deck", "EN"},
+		},
+		{
+			testName:           "Release date range and name",
+			filter:             &ygo.ProductSearchFilter{ReleasedAfter: "2002-01-01", ReleasedBefore: "2002-12-31", Name: " 100%_Dragon "},
+			expectedConditions: []string{"product_release_date >= ?", "product_release_date <= ?", "product_name LIKE ?"},
+			expectedArgs:       []any{"2002-01-01", "2002-12-31", `%100\%\_Dragon%`},
+		},
+		{testName: "Invalid release date", filter: &ygo.ProductSearchFilter{ReleasedAfter: "01/01/2002"}, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			conditions, args, err := buildProductSearchConditions(tt.filter)
+			if tt.expectedCode != codes.OK {
+				assert.Equal(tt.expectedCode, err.Code())
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expectedConditions, conditions)
+			assert.Equal(tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestSetFirstPrinting(t *testing.T) {
+	assert := assert.New(t)
+	product := &ygo.Product{ID: "LOB", ReleaseDate: "2002-03-08"}
+	valid := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+
+	tests := []struct {
+		testName          string
+		productID, date   sql.NullString
+		expectedFirst     bool
+		expectedProductID string
+		expectedDate      string
+	}{
+		{testName: "Earliest product is requested product", productID: valid("LOB"), date: valid("2002-03-08"), expectedFirst: true},
+		{testName: "Earlier product", productID: valid("SDY"), date: valid("2002-01-01"), expectedFirst: false, expectedProductID: "SDY", expectedDate: "2002-01-01"},
+		{testName: "Released same day in another product", productID: valid("SDK"), date: valid("2002-03-08"), expectedFirst: true},
+		{testName: "No first printing data", expectedFirst: true},
+		{testName: "No release date for any product", productID: valid("SDY"), expectedFirst: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			item := &ygo.ProductItem{Position: "001"}
+			setFirstPrinting(item, product, tt.productID, tt.date)
+			assert.Equal(tt.expectedFirst, item.FirstPrinting)
+			assert.Equal(tt.expectedProductID, item.FirstPrintingProductID)
+			assert.Equal(tt.expectedDate, item.FirstPrintingDate)
+		})
+	}
+}
+
+func TestCountPrintings(t *testing.T) {
+	assert := assert.New(t)
+
+	newItems, reprintedItems := countPrintings([]*ygo.ProductItem{
+		{Position: "001", FirstPrinting: true},
+		{Position: "002", FirstPrinting: false, FirstPrintingProductID: "SDY"},
+		{Position: "003", FirstPrinting: false, FirstPrintingProductID: "SDK"},
+		{Position: "004", FirstPrinting: true},
+		{Position: "005", FirstPrinting: true},
+	})
+	assert.Equal(uint32(3), newItems)
+	assert.Equal(uint32(2), reprintedItems)
+
+	newItems, reprintedItems = countPrintings([]*ygo.ProductItem{})
+	assert.Zero(newItems)
+	assert.Zero(reprintedItems)
+}
+
+func TestGroupProductNames(t *testing.T) {
+	assert := assert.New(t)
+
+	requestedNames, args := groupProductNames([]string{"Legend of Blue Eyes White Dragon", "LEGEND OF BLUE EYES WHITE DRAGON", "Metal Raiders", "Legend of Blue Eyes White Dragon"})
+	assert.Equal([]any{"legend of blue eyes white dragon", "metal raiders"}, args, "Each name should only be queried once")
+	assert.Equal(map[string][]string{
+		"legend of blue eyes white dragon": {"Legend of Blue Eyes White Dragon", "LEGEND OF BLUE EYES WHITE DRAGON"},
+		"metal raiders":                    {"Metal Raiders"},
+	}, requestedNames)
+}
+
+func TestGroupCardPrintings(t *testing.T) {
+	assert := assert.New(t)
+	darkMagician, potOfGreed := &ygo.Card{ID: "46986414", Name: "Dark Magician"}, &ygo.Card{ID: "55144522", Name: "Pot of Greed"}
+	lob := &ygo.ProductSummary{ID: "LOB", ReleaseDate: "2002-03-08"}
+	sdy := &ygo.ProductSummary{ID: "SDY", ReleaseDate: "2002-03-29"}
+	ldk2 := &ygo.ProductSummary{ID: "LDK2", ReleaseDate: "2016-10-07"}
+	ldk2SameDay := &ygo.ProductSummary{ID: "LDK2A", ReleaseDate: "2016-10-07"}
+
+	type printing struct {
+		productID, position string
+		rarities            []string
+	}
+	tests := []struct {
+		testName          string
+		rows              []cardPrintingRow
+		cardIDs           []string
+		expectedPrintings map[string][]printing
+		expectedUnknown   []string
+	}{
+		{
+			testName: "Rows of each rarity are grouped",
+			rows: []cardPrintingRow{
+				{card: darkMagician, product: lob, position: "005", rarity: "Ultra Rare"},
+				{card: darkMagician, product: lob, position: "005", rarity: "Secret Rare"},
+				{card: darkMagician, product: sdy, position: "006", rarity: "Common"},
+			},
+			cardIDs: []string{darkMagician.ID},
+			expectedPrintings: map[string][]printing{
+				darkMagician.ID: {{productID: "LOB", position: "005", rarities: []string{"Ultra Rare", "Secret Rare"}}, {productID: "SDY", position: "006", rarities: []string{"Common"}}},
+			},
+			expectedUnknown: []string{},
+		},
+		{
+			testName: "Rows are grouped by card",
+			rows: []cardPrintingRow{
+				{card: darkMagician, product: lob, position: "005", rarity: "Ultra Rare"},
+				{card: potOfGreed, product: lob, position: "039", rarity: "Rare"},
+				{card: darkMagician, product: sdy, position: "006", rarity: "Common"},
+			},
+			cardIDs: []string{darkMagician.ID, potOfGreed.ID},
+			expectedPrintings: map[string][]printing{
+				darkMagician.ID: {{productID: "LOB", position: "005", rarities: []string{"Ultra Rare"}}, {productID: "SDY", position: "006", rarities: []string{"Common"}}},
+				potOfGreed.ID:   {{productID: "LOB", position: "039", rarities: []string{"Rare"}}},
+			},
+			expectedUnknown: []string{},
+		},
+		{
+			testName: "Printings are sorted by release date then product and position",
+			rows: []cardPrintingRow{
+				{card: darkMagician, product: ldk2SameDay, position: "001", rarity: "Common"},
+				{card: darkMagician, product: ldk2, position: "002", rarity: "Common"},
+				{card: darkMagician, product: sdy, position: "006", rarity: "Common"},
+				{card: darkMagician, product: ldk2, position: "001", rarity: "Ultra Rare"},
+				{card: darkMagician, product: lob, position: "005", rarity: "Ultra Rare"},
+			},
+			cardIDs: []string{darkMagician.ID},
+			expectedPrintings: map[string][]printing{
+				darkMagician.ID: {
+					{productID: "LOB", position: "005", rarities: []string{"Ultra Rare"}}, {productID: "SDY", position: "006", rarities: []string{"Common"}},
+					{productID: "LDK2", position: "001", rarities: []string{"Ultra Rare"}}, {productID: "LDK2", position: "002", rarities: []string{"Common"}},
+					{productID: "LDK2A", position: "001", rarities: []string{"Common"}},
+				},
+			},
+			expectedUnknown: []string{},
+		},
+		{
+			testName: "Cards without rows are unknown",
+			rows:     []cardPrintingRow{{card: potOfGreed, product: lob, position: "039", rarity: "Rare"}},
+			cardIDs:  []string{"00000000", potOfGreed.ID, "11111111"},
+			expectedPrintings: map[string][]printing{
+				potOfGreed.ID: {{productID: "LOB", position: "039", rarities: []string{"Rare"}}},
+			},
+			expectedUnknown: []string{"00000000", "11111111"},
+		},
+		{
+			testName:          "No rows",
+			rows:              []cardPrintingRow{},
+			cardIDs:           []string{darkMagician.ID},
+			expectedPrintings: map[string][]printing{},
+			expectedUnknown:   []string{darkMagician.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			results := groupCardPrintings(tt.rows, tt.cardIDs)
+
+			actual := make(map[string][]printing, len(results.CardInfo))
+			for cardID, c := range results.CardInfo {
+				assert.Equal(cardID, c.Card.ID)
+				for _, p := range c.Printings {
+					actual[cardID] = append(actual[cardID], printing{productID: p.Product.ID, position: p.Position, rarities: p.Rarities})
+				}
+			}
+			assert.Equal(tt.expectedPrintings, actual)
+			assert.ElementsMatch(tt.expectedUnknown, results.UnknownResources)
+		})
+	}
+}
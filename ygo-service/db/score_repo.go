@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/ygo-skc/skc-go/common/v2/model"
 	"github.com/ygo-skc/skc-go/common/v2/util"
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 	"google.golang.org/grpc/status"
@@ -117,14 +116,7 @@ func (imp YGOScoreRepository) GetScoresByFormatAndDate(
 				return make([]*ygo.CardScoreEntry, 0), 0, handleRowParsingError(util.RetrieveLogger(ctx), err)
 			}
 			entries = append(entries, &ygo.CardScoreEntry{
-				Card: model.NewYGOCardProtoBuilder(id, name).
-					WithColor(color).
-					WithAttribute(attribute).
-					WithEffect(effect).
-					WithMonsterType(monsterType).
-					WithAttack(atk).
-					WithDefense(def).
-					Build(),
+				Card:  buildCard(id, color, name, attribute, effect, monsterType, atk, def),
 				Score: score,
 			})
 			numEntries++
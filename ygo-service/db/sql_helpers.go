@@ -2,10 +2,13 @@ package db
 
 import (
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"log/slog"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ygo-skc/skc-go/common/v2/ygo"
 	"google.golang.org/grpc/codes"
@@ -19,6 +22,12 @@ const (
 var (
 	spaceRegex = regexp.MustCompile(`[ ]+`)
 	quoteRegex = regexp.MustCompile(`['"]`)
+
+	likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+)
+
+const (
+	maxArchetypeLength = 50
 )
 
 func handleQueryError(logger *slog.Logger, err error) *status.Status {
@@ -70,3 +79,96 @@ func variablePlaceholders(totalFields int) string {
 		return fmt.Sprintf("?%s", strings.Repeat(", ?", totalFields-1))
 	}
 }
+
+// ie: (?, ?), (?, ?) for 2 tuples of size 2
+func tuplePlaceholders(numTuples, tupleSize int) string {
+	if numTuples == 0 {
+		return ""
+	}
+
+	tuple := fmt.Sprintf("(%s)", variablePlaceholders(tupleSize))
+	return tuple + strings.Repeat(", "+tuple, numTuples-1)
+}
+
+// escapes wildcard characters so user input is matched literally by LIKE
+func escapeLike(subject string) string {
+	return likeEscaper.Replace(subject)
+}
+
+// archetypes are quoted in card text, meaning they cannot contain double quotes themselves
+func validateArchetype(archetype string) *status.Status {
+	switch {
+	case strings.TrimSpace(archetype) == "":
+		return status.New(codes.InvalidArgument, "Archetype is required")
+	case utf8.RuneCountInString(archetype) > maxArchetypeLength:
+		return status.New(codes.InvalidArgument, fmt.Sprintf("Archetype cannot exceed %d characters", maxArchetypeLength))
+	case !utf8.ValidString(archetype):
+		return status.New(codes.InvalidArgument, "Archetype is not valid UTF-8")
+	case strings.ContainsFunc(archetype, func(r rune) bool { return r == '"' || unicode.IsControl(r) }):
+		return status.New(codes.InvalidArgument, "Archetype contains unsupported characters")
+	}
+	return nil
+}
+
+// Every word of the clause and archetype needs to be present. Archetype is treated as a phrase so boolean mode operators (+, -, *, etc) it contains have no effect.
+// Archetype should be validated first as a double quote would end the phrase.
+func archetypeFullTextQuery(clause, archetype string) string {
+	return fmt.Sprintf(`+"%s" +"%s"`, clause, archetype)
+}
+
+// Full text search ignores punctuation and word order, REGEXP is used to confirm archetype is quoted after the clause.
+func archetypeClauseRegexp(clausePattern, archetype string) string {
+	return fmt.Sprintf(`%s"%s".* card`, clausePattern, escapeRegexp(archetype))
+}
+
+// MySQL uses ICU regular expressions while Go uses RE2. Escaping every ASCII punctuation character (instead of only the characters Go treats as special)
+// keeps the result to literals and backslash escaped punctuation, which both engines match literally.
+func escapeRegexp(subject string) string {
+	var sb strings.Builder
+	for _, r := range subject {
+		if r < utf8.RuneSelf && (unicode.IsPunct(r) || unicode.IsSymbol(r)) {
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func joinConditions(conditions []string) string {
+	if len(conditions) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(conditions, "\n\tAND ")
+}
+
+// cursors are opaque to clients - they wrap the ID of the last resource in a page
+func encodeCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	if id, err := base64.RawURLEncoding.DecodeString(cursor); err != nil {
+		return "", err
+	} else {
+		return string(id), nil
+	}
+}
+
+// Pages start after the resource wrapped by the cursor, a cursor for a resource that does not exist would silently return an empty page.
+// existsQuery takes the ID as its only argument.
+func validateCursor(logger *slog.Logger, existsQuery string, cursor string) (string, *status.Status) {
+	id, err := decodeCursor(cursor)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Could not decode cursor %s - %v", cursor, err))
+		return "", status.New(codes.InvalidArgument, "Invalid cursor")
+	}
+
+	var exists bool
+	if err := skcDBConn.QueryRow(existsQuery, id).Scan(&exists); err != nil {
+		return "", handleQueryError(logger, err)
+	} else if !exists {
+		logger.Warn(fmt.Sprintf("Cursor references unknown resource %s", id))
+		return "", status.New(codes.InvalidArgument, "Invalid cursor")
+	}
+	return id, nil
+}
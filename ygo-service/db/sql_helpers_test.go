@@ -0,0 +1,166 @@
+package db
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+var adversarialArchetypes = []string{
+	`Blue-Eyes`,
+	`D/D`,
+	`Dark Magician's`,
+	`'; DROP TABLE card_info; --`,
+	`HERO') OR 1=1 --`,
+	`+Fossil -HERO`,
+	`Fossil*`,
+	`(Fossil)`,
+	`@Ignister`,
+	`~Frog <>`,
+	`.*`,
+	`[A-Z]+`,
+	`^$|?`,
+	`Number C\39`,
+	`100%`,
+	`T_G`,
+}
+
+func TestValidateArchetype(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		testName  string
+		archetype string
+		valid     bool
+	}{
+		{testName: "Regular archetype", archetype: "HERO", valid: true},
+		{testName: "Archetype w/ symbols", archetype: "D/D/D", valid: true},
+		{testName: "Archetype w/ apostrophe", archetype: "Dark Magician's", valid: true},
+		{testName: "Empty archetype", archetype: "", valid: false},
+		{testName: "Blank archetype", archetype: "  \t", valid: false},
+		{testName: "Double quote closes full text phrase", archetype: `Fossil" -"HERO`, valid: false},
+		{testName: "Control character", archetype: "HERO\x00", valid: false},
+		{testName: "New line", archetype: "HERO\n", valid: false},
+		{testName: "Invalid UTF-8", archetype: "HERO\xff", valid: false},
+		{testName: "Too long", archetype: strings.Repeat("a", maxArchetypeLength+1), valid: false},
+		{testName: "Max length multi-byte", archetype: strings.Repeat("★", maxArchetypeLength), valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := validateArchetype(tt.archetype)
+			if tt.valid {
+				assert.Nil(err)
+			} else {
+				assert.NotNil(err)
+				assert.Equal(codes.InvalidArgument, err.Code())
+			}
+		})
+	}
+
+	for _, archetype := range adversarialArchetypes {
+		assert.Nil(validateArchetype(archetype), "Archetype %s should be accepted and handled safely", archetype)
+	}
+}
+
+func TestArchetypeFullTextQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`+"This card is always treated as" +"HERO"`, archetypeFullTextQuery("This card is always treated as", "HERO"))
+
+	// boolean mode operators are inside the phrase, meaning they are not interpreted
+	for _, archetype := range adversarialArchetypes {
+		q := archetypeFullTextQuery("This card is always treated as", archetype)
+		assert.Equal(4, strings.Count(q, `"`), "Phrase of archetype %s is not closed properly", archetype)
+		assert.True(strings.HasSuffix(q, `+"`+archetype+`"`))
+	}
+}
+
+func TestArchetypeClauseRegexp(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, archetype := range adversarialArchetypes {
+		re, err := regexp.Compile(archetypeClauseRegexp("always treated as a.*", archetype))
+		assert.Nil(err, "Pattern for archetype %s should compile", archetype)
+
+		member := `(This card is always treated as a "` + archetype + `" card.)`
+		assert.True(re.MatchString(member), "Archetype %s should match itself", archetype)
+
+		other := `(This card is always treated as a "Fossil" card.)`
+		if archetype != "Fossil" {
+			assert.False(re.MatchString(other), "Archetype %s should only match literally", archetype)
+		}
+	}
+
+	re := regexp.MustCompile(archetypeClauseRegexp("not treated as.*", "Frog"))
+	assert.True(re.MatchString(`(This card is not treated as a "Frog" card.)`))
+	assert.False(re.MatchString(`(This card is always treated as a "Frog" card.)`))
+}
+
+// ICU and RE2 disagree on several escapes (\Q...\E, \x{...}, \p, etc) so patterns sent to MySQL should only contain literals or escaped ASCII punctuation.
+func TestEscapeRegexp(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`Blue\-Eyes`, escapeRegexp("Blue-Eyes"))
+	assert.Equal(`Number C\\39`, escapeRegexp(`Number C\39`))
+	assert.Equal(`\[A\-Z\]\+`, escapeRegexp("[A-Z]+"))
+	assert.Equal("Ｅ・ＨＥＲＯ", escapeRegexp("Ｅ・ＨＥＲＯ"), "Non ASCII characters are literals in both engines")
+
+	for _, archetype := range adversarialArchetypes {
+		escaped := []rune(escapeRegexp(archetype))
+		for i := 0; i < len(escaped); i++ {
+			r := escaped[i]
+			if r == '\\' {
+				i++
+				assert.Less(i, len(escaped), "Pattern for archetype %s ends in a dangling escape", archetype)
+				if i < len(escaped) {
+					assert.True(escaped[i] < utf8.RuneSelf && (unicode.IsPunct(escaped[i]) || unicode.IsSymbol(escaped[i])), "Archetype %s escapes %q which is not portable", archetype, escaped[i])
+				}
+				continue
+			}
+			assert.False(r < utf8.RuneSelf && (unicode.IsPunct(r) || unicode.IsSymbol(r)), "Archetype %s leaves %q unescaped", archetype, r)
+		}
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		subject  string
+		expected string
+	}{
+		{subject: "HERO", expected: "HERO"},
+		{subject: "100%", expected: `100\%`},
+		{subject: "T_G", expected: `T\_G`},
+		{subject: `Number C\39`, expected: `Number C\\39`},
+		{subject: `%_\`, expected: `\%\_\\`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.expected, escapeLike(tt.subject))
+	}
+}
+
+func TestTuplePlaceholders(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		numTuples, tupleSize int
+		expected             string
+	}{
+		{0, 2, ""},
+		{1, 2, "(?, ?)"},
+		{3, 2, "(?, ?), (?, ?), (?, ?)"},
+		{2, 1, "(?), (?)"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.expected, tuplePlaceholders(test.numTuples, test.tupleSize), "Placeholders for %d tuple(s) of size %d", test.numTuples, test.tupleSize)
+	}
+}
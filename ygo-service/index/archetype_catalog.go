@@ -0,0 +1,147 @@
+package index
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ygo-skc/skc-go/common/v2/parser"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+type idSet map[string]struct{}
+
+func addToIDSet(sets map[string]idSet, key, id string) {
+	if _, exists := sets[key]; !exists {
+		sets[key] = make(idSet)
+	}
+	sets[key][id] = struct{}{}
+}
+
+// Collects archetype data card by card so the entire card table does not need to be in memory when building the catalog.
+type ArchetypeCatalogBuilder struct {
+	names        map[string]string
+	treatedAs    map[string]idSet
+	notTreatedAs map[string]idSet
+	referencing  map[string]idSet
+}
+
+func NewArchetypeCatalogBuilder() *ArchetypeCatalogBuilder {
+	return &ArchetypeCatalogBuilder{
+		names:        make(map[string]string, 15000),
+		treatedAs:    make(map[string]idSet),
+		notTreatedAs: make(map[string]idSet),
+		referencing:  make(map[string]idSet),
+	}
+}
+
+func (b *ArchetypeCatalogBuilder) Add(c *ygo.Card) {
+	b.names[c.ID] = c.Name
+
+	refs := parser.ParseArchetypeReferences(c.Effect)
+	for _, archetype := range refs.TreatedAs {
+		addToIDSet(b.treatedAs, archetype, c.ID)
+	}
+	for _, archetype := range refs.NotTreatedAs {
+		addToIDSet(b.notTreatedAs, archetype, c.ID)
+	}
+	for _, archetype := range refs.Referenced {
+		addToIDSet(b.referencing, archetype, c.ID)
+	}
+}
+
+// Membership follows the same rules as GetArchetypeMembers - name matches and explicit inclusions, minus explicit exclusions.
+// Archetypes without members are assumed to be card names or typos and are left out.
+func (b *ArchetypeCatalogBuilder) Build(refreshedAt time.Time) *ygo.ArchetypeCatalog {
+	archetypes := make(map[string]struct{}, len(b.referencing)+len(b.treatedAs))
+	for _, source := range []map[string]idSet{b.treatedAs, b.notTreatedAs, b.referencing} {
+		for archetype := range source {
+			archetypes[archetype] = struct{}{}
+		}
+	}
+
+	catalog := &ygo.ArchetypeCatalog{
+		Archetypes:  make([]*ygo.ArchetypeSummary, 0, len(archetypes)),
+		RefreshedAt: refreshedAt.Format(time.RFC3339),
+	}
+	names := newNameTrigrams(b.names)
+	for archetype := range archetypes {
+		excluded := b.notTreatedAs[archetype]
+		members := make(idSet)
+
+		var nameMatches uint32
+		for _, pos := range names.candidates(archetype) {
+			if id := names.ids[pos]; strings.Contains(names.names[pos], archetype) {
+				nameMatches++
+				if _, isExcluded := excluded[id]; !isExcluded {
+					members[id] = struct{}{}
+				}
+			}
+		}
+		for id := range b.treatedAs[archetype] {
+			if _, isExcluded := excluded[id]; !isExcluded {
+				members[id] = struct{}{}
+			}
+		}
+
+		if len(members) == 0 {
+			continue
+		}
+		catalog.Archetypes = append(catalog.Archetypes, &ygo.ArchetypeSummary{
+			Name:               archetype,
+			MemberCount:        uint32(len(members)),
+			NameMatches:        nameMatches,
+			ExplicitInclusions: uint32(len(b.treatedAs[archetype])),
+			ExplicitExclusions: uint32(len(excluded)),
+			ReferencingCards:   uint32(len(b.referencing[archetype])),
+		})
+	}
+
+	slices.SortFunc(catalog.Archetypes, func(a, b *ygo.ArchetypeSummary) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return catalog
+}
+
+// Exact (case sensitive) byte trigrams of card names. Only names containing the least common trigram of an archetype can contain the archetype,
+// which avoids comparing every archetype against every name.
+type nameTrigrams struct {
+	ids      []string
+	names    []string
+	postings map[string][]int32
+	all      []int32 // used for archetypes too short to have a trigram
+}
+
+func newNameTrigrams(names map[string]string) *nameTrigrams {
+	t := &nameTrigrams{ids: make([]string, 0, len(names)), names: make([]string, 0, len(names)), postings: make(map[string][]int32, 32768),
+		all: make([]int32, 0, len(names))}
+
+	for id, name := range names {
+		pos := int32(len(t.ids))
+		t.ids, t.names, t.all = append(t.ids, id), append(t.names, name), append(t.all, pos)
+
+		for i := 0; i+3 <= len(name); i++ {
+			trigram := name[i : i+3]
+			// a name repeating a trigram should only be added once
+			if postings := t.postings[trigram]; len(postings) == 0 || postings[len(postings)-1] != pos {
+				t.postings[trigram] = append(postings, pos)
+			}
+		}
+	}
+	return t
+}
+
+// positions of names that might contain archetype, callers still need to confirm the match
+func (t *nameTrigrams) candidates(archetype string) []int32 {
+	if len(archetype) < 3 {
+		return t.all
+	}
+
+	best := t.postings[archetype[:3]]
+	for i := 1; i+3 <= len(archetype) && len(best) != 0; i++ {
+		if postings := t.postings[archetype[i:i+3]]; len(postings) < len(best) {
+			best = postings
+		}
+	}
+	return best
+}
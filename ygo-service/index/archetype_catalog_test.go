@@ -0,0 +1,67 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+func TestArchetypeCatalogBuild(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewArchetypeCatalogBuilder()
+	for _, c := range []*ygo.Card{
+		{ID: "1", Name: "Elemental HERO Neos", Effect: "A new Elemental HERO has arrived from Neo-Space!"},
+		{ID: "2", Name: "Elemental HERO Avian", Effect: "A winged Elemental HERO who wheels through the sky."},
+		{ID: "3", Name: "Neo-Spacian Air Hummingbird", Effect: `Gain 500 LP for each card in your opponent's hand. You can add 1 "HERO" monster from your Deck to your hand.`},
+		{ID: "4", Name: "Vision HERO Vyon", Effect: `You can send 1 "HERO" monster from your Deck to the GY.`},
+		{ID: "5", Name: "Heroic Challenger - Extra Sword", Effect: `(This card is always treated as a "HERO" card.)`},
+		{ID: "6", Name: "Shining Hero Neos", Effect: `(This card is not treated as a "HERO" card.)`},
+		{ID: "7", Name: "Fossil Dyna Pachycephalo", Effect: `You can add 1 "Typo Archetype" monster from your Deck to your hand.`},
+		{ID: "8", Name: "D/D Savant Kepler", Effect: `You can add 1 "D/D" card from your Deck to your hand.`},
+	} {
+		b.Add(c)
+	}
+
+	refreshedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	catalog := b.Build(refreshedAt)
+	assert.Equal("2026-01-02T03:04:05Z", catalog.RefreshedAt)
+
+	names := make([]string, len(catalog.Archetypes))
+	for i, a := range catalog.Archetypes {
+		names[i] = a.Name
+	}
+	assert.Equal([]string{"D/D", "HERO"}, names, "Archetypes should be sorted and archetypes without members left out")
+
+	assert.Equal(&ygo.ArchetypeSummary{Name: "D/D", MemberCount: 1, NameMatches: 1, ReferencingCards: 1}, catalog.Archetypes[0])
+	assert.Equal(&ygo.ArchetypeSummary{Name: "HERO", MemberCount: 4, NameMatches: 3, ExplicitInclusions: 1, ExplicitExclusions: 1, ReferencingCards: 2},
+		catalog.Archetypes[1])
+}
+
+func TestNameTrigramCandidates(t *testing.T) {
+	assert := assert.New(t)
+	names := newNameTrigrams(map[string]string{"1": "Elemental HERO Neos", "2": "Neo-Spacian Aqua Dolphin", "3": "K9-17 Izuna", "4": "Heroic Challenger"})
+
+	tests := []struct {
+		archetype     string
+		expectedNames []string
+	}{
+		{archetype: "HERO", expectedNames: []string{"Elemental HERO Neos"}},
+		{archetype: "Neo", expectedNames: []string{"Elemental HERO Neos", "Neo-Spacian Aqua Dolphin"}},
+		{archetype: "Hero", expectedNames: []string{"Heroic Challenger"}},
+		{archetype: "K9", expectedNames: []string{"Elemental HERO Neos", "Neo-Spacian Aqua Dolphin", "K9-17 Izuna", "Heroic Challenger"}},
+		{archetype: "Missing", expectedNames: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.archetype, func(t *testing.T) {
+			candidates := make([]string, 0)
+			for _, pos := range names.candidates(tt.archetype) {
+				candidates = append(candidates, names.names[pos])
+			}
+			assert.ElementsMatch(tt.expectedNames, candidates)
+		})
+	}
+}
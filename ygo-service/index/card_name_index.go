@@ -0,0 +1,131 @@
+package index
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/ygo-skc/skc-go/common/v2/parser"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+const (
+	maxCandidates  = 200 // number of trigram matches that are re-scored using edit distance
+	minScore       = 0.35
+	prefixBaseline = 0.9
+	wordBaseline   = 0.75
+)
+
+type indexedName struct {
+	id         string
+	name       string
+	normalized string
+	trigrams   int
+}
+
+// In memory trigram index of card names used for autocomplete and typo tolerant lookups.
+type CardNameIndex struct {
+	names    []indexedName
+	postings map[string][]int32
+}
+
+// Builds index using a map of card ID to card name.
+func NewCardNameIndex(cardNames map[string]string) *CardNameIndex {
+	idx := &CardNameIndex{
+		names:    make([]indexedName, 0, len(cardNames)),
+		postings: make(map[string][]int32, 8192),
+	}
+
+	for id, name := range cardNames {
+		normalized := parser.NormalizeForFuzzyMatch(name)
+		trigrams := parser.Trigrams(normalized)
+		pos := int32(len(idx.names))
+
+		idx.names = append(idx.names, indexedName{id: id, name: name, normalized: normalized, trigrams: len(trigrams)})
+		for _, trigram := range trigrams {
+			idx.postings[trigram] = append(idx.postings[trigram], pos)
+		}
+	}
+	return idx
+}
+
+func (idx *CardNameIndex) Size() int {
+	return len(idx.names)
+}
+
+// Returns up to limit names most similar to query, best match first.
+func (idx *CardNameIndex) Suggest(query string, limit int) []*ygo.CardNameSuggestion {
+	if idx == nil || limit <= 0 {
+		return []*ygo.CardNameSuggestion{}
+	}
+
+	normalizedQuery := parser.NormalizeForFuzzyMatch(query)
+	if normalizedQuery == "" {
+		return []*ygo.CardNameSuggestion{}
+	}
+	queryTrigrams := parser.Trigrams(normalizedQuery)
+
+	// count trigrams shared between query and every name containing at least one of them
+	shared := make(map[int32]int, 512)
+	for _, trigram := range queryTrigrams {
+		for _, pos := range idx.postings[trigram] {
+			shared[pos]++
+		}
+	}
+
+	type candidate struct {
+		pos   int32
+		score float64
+	}
+	candidates := make([]candidate, 0, len(shared))
+	for pos, numShared := range shared {
+		dice := 2 * float64(numShared) / float64(len(queryTrigrams)+idx.names[pos].trigrams)
+		candidates = append(candidates, candidate{pos: pos, score: dice})
+	}
+	// ties are broken by name and ID so the same candidates are kept no matter the order of map iteration
+	byScore := func(a, b candidate) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(idx.names[a.pos].name, idx.names[b.pos].name); c != 0 {
+			return c
+		}
+		return cmp.Compare(idx.names[a.pos].id, idx.names[b.pos].id)
+	}
+	slices.SortFunc(candidates, byScore)
+	candidates = candidates[:min(len(candidates), maxCandidates)]
+
+	// re-score best candidates using edit distance and prefix matching which are more expensive but better reflect what user meant
+	for i, c := range candidates {
+		candidates[i].score = similarity(normalizedQuery, idx.names[c.pos].normalized, c.score)
+	}
+	slices.SortFunc(candidates, byScore)
+
+	suggestions := make([]*ygo.CardNameSuggestion, 0, limit)
+	for _, c := range candidates {
+		if len(suggestions) == limit || c.score < minScore {
+			break
+		}
+		n := idx.names[c.pos]
+		suggestions = append(suggestions, &ygo.CardNameSuggestion{ID: n.id, Name: n.name, Score: float32(c.score)})
+	}
+	return suggestions
+}
+
+func similarity(query, name string, dice float64) float64 {
+	if query == name {
+		return 1
+	}
+
+	queryLen, nameLen := len([]rune(query)), len([]rune(name))
+	editSimilarity := 1 - float64(parser.LevenshteinDistance(query, name))/float64(max(queryLen, nameLen))
+	score := max(dice, editSimilarity)
+
+	// partially typed names should rank above names that only happen to share some trigrams
+	if strings.HasPrefix(name, query) {
+		score = max(score, prefixBaseline+(1-prefixBaseline)*float64(queryLen)/float64(nameLen))
+	} else if strings.Contains(name, " "+query) {
+		score = max(score, wordBaseline+(prefixBaseline-wordBaseline)*float64(queryLen)/float64(nameLen))
+	}
+	return min(score, 0.99)
+}
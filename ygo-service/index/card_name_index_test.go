@@ -0,0 +1,74 @@
+package index
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var cardNames = map[string]string{
+	"89631139": "Blue-Eyes White Dragon",
+	"23995346": "Blue-Eyes Ultimate Dragon",
+	"38517737": "Blue-Eyes Alternative White Dragon",
+	"46986414": "Dark Magician",
+	"38033121": "Dark Magician Girl",
+	"70781052": "Summoned Skull",
+	"74677422": "Red-Eyes Black Dragon",
+}
+
+func TestSuggest(t *testing.T) {
+	assert := assert.New(t)
+	idx := NewCardNameIndex(cardNames)
+
+	tests := []struct {
+		testName      string
+		query         string
+		limit         int
+		expectedFirst string
+	}{
+		{testName: "Exact name", query: "Dark Magician", limit: 3, expectedFirst: "Dark Magician"},
+		{testName: "Typo", query: "Blue-Eyes Whte Dragon", limit: 3, expectedFirst: "Blue-Eyes White Dragon"},
+		{testName: "Partially typed", query: "summoned sk", limit: 3, expectedFirst: "Summoned Skull"},
+		{testName: "Different case and punctuation", query: "red eyes black dragon", limit: 3, expectedFirst: "Red-Eyes Black Dragon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			suggestions := idx.Suggest(tt.query, tt.limit)
+			if assert.NotEmpty(suggestions) {
+				assert.Equal(tt.expectedFirst, suggestions[0].Name)
+			}
+			assert.LessOrEqual(len(suggestions), tt.limit)
+			for i := 1; i < len(suggestions); i++ {
+				assert.GreaterOrEqual(suggestions[i-1].Score, suggestions[i].Score, "Suggestions should be sorted by score")
+			}
+		})
+	}
+
+	assert.Empty(idx.Suggest("", 3))
+	assert.Empty(idx.Suggest("Dark Magician", 0))
+	assert.Empty(idx.Suggest("zzzzqqqq", 3))
+}
+
+func TestSuggestTiesAreDeterministic(t *testing.T) {
+	assert := assert.New(t)
+
+	// names sharing the same trigrams with the query tie on score, more names than candidates forces truncation
+	names := make(map[string]string, 2*maxCandidates)
+	for i := range 2 * maxCandidates {
+		names[fmt.Sprintf("%08d", i)] = fmt.Sprintf("Token %03d", i)
+	}
+
+	expected := NewCardNameIndex(names).Suggest("Token", 5)
+	assert.Len(expected, 5)
+	for i := 1; i < len(expected); i++ {
+		if expected[i-1].Score == expected[i].Score {
+			assert.Less(expected[i-1].Name, expected[i].Name, "Ties should be sorted by name")
+		}
+	}
+
+	for range 10 {
+		assert.Equal(expected, NewCardNameIndex(names).Suggest("Token", 5))
+	}
+}
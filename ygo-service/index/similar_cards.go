@@ -0,0 +1,195 @@
+package index
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+)
+
+const (
+	maxDocumentFrequency = 0.5 // terms found in more cards than this ratio do not help tell cards apart
+	featureWeight        = 1.5 // color, attribute and monster type terms are weighed higher than a single word of an effect
+	minEffectTermLength  = 3
+)
+
+var stopWords = map[string]struct{}{
+	"the": {}, "and": {}, "you": {}, "your": {}, "this": {}, "that": {}, "can": {}, "for": {}, "from": {}, "with": {}, "its": {},
+	"are": {}, "was": {}, "then": {}, "also": {}, "any": {}, "all": {}, "each": {}, "has": {}, "have": {}, "into": {}, "but": {},
+	"not": {}, "they": {}, "them": {}, "their": {}, "than": {}, "while": {}, "when": {}, "only": {}, "other": {}, "those": {},
+}
+
+type similarityTerm struct {
+	term   int32
+	weight float32
+}
+
+type similarCardDocument struct {
+	id    string
+	terms []similarityTerm // sorted by term, weights are normalized so the dot product of two documents is their cosine similarity
+}
+
+// In memory TF-IDF index of card effects combined with color, attribute and monster type. Used to find cards similar to a given card.
+type SimilarCardIndex struct {
+	documents []similarCardDocument
+	positions map[string]int32
+	postings  [][]int32 // term -> documents containing term
+}
+
+type SimilarCard struct {
+	ID    string
+	Score float32
+}
+
+type termCount struct {
+	term  int32
+	count int32
+}
+
+// terms are interned as cards are added to keep memory usage low while building the index
+type SimilarCardIndexBuilder struct {
+	ids               []string
+	documents         [][]termCount
+	terms             map[string]int32
+	isFeature         []bool
+	documentFrequency []int32
+}
+
+func NewSimilarCardIndexBuilder() *SimilarCardIndexBuilder {
+	return &SimilarCardIndexBuilder{ids: make([]string, 0, 16384), documents: make([][]termCount, 0, 16384), terms: make(map[string]int32, 32768)}
+}
+
+func (b *SimilarCardIndexBuilder) Add(c *ygo.Card) {
+	counts := make(map[int32]int32, 64)
+	for _, word := range effectTerms(c.Effect) {
+		counts[b.intern(word, false)]++
+	}
+	for _, feature := range cardFeatures(c) {
+		counts[b.intern(feature, true)] = 1
+	}
+
+	document := make([]termCount, 0, len(counts))
+	for term, count := range counts {
+		document = append(document, termCount{term: term, count: count})
+		b.documentFrequency[term]++
+	}
+	slices.SortFunc(document, func(a, c termCount) int { return cmp.Compare(a.term, c.term) })
+
+	b.ids = append(b.ids, c.ID)
+	b.documents = append(b.documents, document)
+}
+
+func (b *SimilarCardIndexBuilder) intern(term string, isFeature bool) int32 {
+	if id, exists := b.terms[term]; exists {
+		return id
+	}
+
+	id := int32(len(b.isFeature))
+	b.terms[term] = id
+	b.isFeature = append(b.isFeature, isFeature)
+	b.documentFrequency = append(b.documentFrequency, 0)
+	return id
+}
+
+func (b *SimilarCardIndexBuilder) Build() *SimilarCardIndex {
+	numDocuments := float64(len(b.ids))
+	idx := &SimilarCardIndex{documents: make([]similarCardDocument, len(b.ids)), positions: make(map[string]int32, len(b.ids)), postings: make([][]int32, len(b.isFeature))}
+
+	for pos, document := range b.documents {
+		terms := make([]similarityTerm, 0, len(document))
+		norm := 0.0
+		for _, t := range document {
+			df := float64(b.documentFrequency[t.term])
+			if df/numDocuments > maxDocumentFrequency || df < 2 {
+				continue // terms unique to a single card cannot match any other card
+			}
+
+			weight := (1 + math.Log(float64(t.count))) * math.Log(numDocuments/df)
+			if b.isFeature[t.term] {
+				weight *= featureWeight
+			}
+			idx.postings[t.term] = append(idx.postings[t.term], int32(pos))
+			terms = append(terms, similarityTerm{term: t.term, weight: float32(weight)})
+			norm += weight * weight
+		}
+
+		for i := range terms {
+			terms[i].weight /= float32(math.Sqrt(norm))
+		}
+		idx.documents[pos] = similarCardDocument{id: b.ids[pos], terms: terms}
+		idx.positions[b.ids[pos]] = int32(pos)
+	}
+	return idx
+}
+
+func (idx *SimilarCardIndex) Size() int {
+	return len(idx.documents)
+}
+
+// Up to limit cards most similar to the card with ID id, most similar first. False if card is not part of the index.
+func (idx *SimilarCardIndex) Similar(id string, limit int) ([]SimilarCard, bool) {
+	pos, exists := idx.positions[id]
+	if !exists {
+		return nil, false
+	}
+
+	scores := make(map[int32]float32, 1024)
+	for _, t := range idx.documents[pos].terms {
+		for _, other := range idx.postings[t.term] {
+			if other != pos {
+				scores[other] += t.weight * idx.documents[other].weight(t.term)
+			}
+		}
+	}
+
+	similar := make([]SimilarCard, 0, len(scores))
+	for other, score := range scores {
+		similar = append(similar, SimilarCard{ID: idx.documents[other].id, Score: score})
+	}
+	slices.SortFunc(similar, func(a, b SimilarCard) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return similar[:min(len(similar), limit)], true
+}
+
+func (d similarCardDocument) weight(term int32) float32 {
+	if i, found := slices.BinarySearchFunc(d.terms, term, func(t similarityTerm, term int32) int { return cmp.Compare(t.term, term) }); found {
+		return d.terms[i].weight
+	}
+	return 0
+}
+
+func effectTerms(effect string) []string {
+	words := strings.FieldsFunc(strings.ToLower(effect), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, isStopWord := stopWords[word]; !isStopWord && len(word) >= minEffectTermLength {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// features use a prefix so they never collide with an effect term. Monster type tokens that are part of the color (Effect, Fusion, etc) are skipped
+// so they are not counted twice.
+func cardFeatures(c *ygo.Card) []string {
+	features := []string{"color:" + c.Color, "attribute:" + c.Attribute}
+	if c.MonsterType != nil {
+		color := strings.ToUpper(c.Color)
+		for _, token := range strings.Split(c.MonsterType.Value, "/") {
+			if token = strings.TrimSpace(token); !strings.Contains(color, strings.ToUpper(token)) {
+				features = append(features, "type:"+token)
+			}
+		}
+	}
+	return features
+}
@@ -0,0 +1,44 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ygo-skc/skc-go/common/v2/ygo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSimilarCardIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewSimilarCardIndexBuilder()
+	for _, c := range []*ygo.Card{
+		{ID: "1", Color: "Effect", Attribute: "DARK", MonsterType: wrapperspb.String("Spellcaster/Effect"), Effect: "Destroy all Spell and Trap cards your opponent controls."},
+		{ID: "2", Color: "Effect", Attribute: "DARK", MonsterType: wrapperspb.String("Spellcaster/Effect"), Effect: "Destroy all Spell and Trap cards on the field."},
+		{ID: "3", Color: "Effect", Attribute: "LIGHT", MonsterType: wrapperspb.String("Fairy/Effect"), Effect: "Gain 1000 LP during each Standby Phase."},
+		{ID: "4", Color: "Spell", Attribute: "SPELL", Effect: "Draw 2 cards, then gain 1000 LP."},
+		{ID: "5", Color: "Trap", Attribute: "TRAP", Effect: "Negate the activation of a Spell Card."},
+		{ID: "6", Color: "Trap", Attribute: "TRAP", Effect: "Destroy 1 monster your opponent controls."},
+	} {
+		b.Add(c)
+	}
+	idx := b.Build()
+	assert.Equal(6, idx.Size())
+
+	similar, exists := idx.Similar("1", 2)
+	assert.True(exists)
+	if assert.Len(similar, 2) {
+		assert.Equal("2", similar[0].ID, "Card w/ same effect and features should be most similar")
+		assert.Equal("6", similar[1].ID)
+		assert.Greater(similar[0].Score, similar[1].Score)
+	}
+	assert.LessOrEqual(similar[0].Score, float32(1.0001))
+
+	similar, _ = idx.Similar("3", 10)
+	for _, s := range similar {
+		assert.NotEqual("3", s.ID, "Card should not be similar to itself")
+	}
+
+	_, exists = idx.Similar("unknown", 10)
+	assert.False(exists)
+}